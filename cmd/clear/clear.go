@@ -5,6 +5,7 @@ import (
 	"file-sharing/config"
 	"file-sharing/internal/lib/filelib"
 	"file-sharing/internal/services/db"
+	"file-sharing/internal/storage"
 	"flag"
 	"fmt"
 	"log"
@@ -20,19 +21,19 @@ import (
 
 func main() {
 	clearDB := flag.Bool("clear-db", false, "Clear database")
-	flag.Parse()
-
-	// prevent error on read dir
-	if filelib.CreateDir() != nil {
-		return
+	cfg, err := config.Load(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	// read large/small directories
-	ld, err := os.ReadDir(config.LARGE_PATH)
+	ctx := context.Background()
+	st, err := storage.New(cfg)
 	if err != nil {
 		log.Fatal(err)
 	}
-	sd, err := os.ReadDir(config.SMALL_PATH)
+
+	// read large/small directories
+	ld, sd, err := filelib.ReadDeleteDir(ctx, st)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -42,7 +43,7 @@ func main() {
 	infoSmall := filelib.CreateFileInfo(sd)
 
 	// creates delete log
-	file, err := filelib.CreateDeleteLogFile(infoLarge, infoSmall, config.CLEAR_LOG_PATH)
+	file, err := filelib.CreateDeleteLogFile(infoLarge, infoSmall, cfg.ClearLogPath())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -50,11 +51,13 @@ func main() {
 	fmt.Printf("Delete logs created:  %v\n", file.Name())
 
 	// clear files
-	err = os.RemoveAll(config.LARGE_PATH)
-	if err != nil {
-		log.Fatal(err)
+	for _, info := range append(ld, sd...) {
+		err = st.Delete(ctx, info.Key)
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
-	err = os.RemoveAll(config.SMALL_PATH)
+	err = os.RemoveAll(cfg.PartialPath())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -63,9 +66,13 @@ func main() {
 
 	// clear db
 	if *clearDB {
-		client := db.Connect(config.DB_PATH, true)
+		client := db.Connect(cfg.DBPath, true)
 		defer client.Close()
-		client.File.Delete().ExecX(context.Background())
+		client.File.Delete().ExecX(ctx)
+		client.Upload.Delete().ExecX(ctx)
+		client.Blob.Delete().ExecX(ctx)
+		client.Bundle.Delete().ExecX(ctx)
+		client.Link.Delete().ExecX(ctx)
 		fmt.Println("Successfully clear files in database")
 	}
 }
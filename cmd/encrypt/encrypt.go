@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"file-sharing/config"
+	"file-sharing/internal/services"
+	"file-sharing/internal/services/db"
+	"file-sharing/internal/storage"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+// Encrypts stored plaintext content and wraps data keys again with the first of encryption keys,
+// run it after enabling encryption or putting a new master key first, then old master keys can be removed.
+
+// stop the app first, content is replaced in storage
+// docker compose run --rm encrypt
+
+func main() {
+	cfg, err := config.Load(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	st, err := storage.New(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client := db.Connect(cfg.DBPath, true)
+	defer client.Close()
+
+	encrypted, rewrapped, err := services.NewBlob(client, st, cfg).EncryptStored(ctx)
+	fmt.Printf("Encrypted %v stored contents, wrapped %v data keys again\n", encrypted, rewrapped)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
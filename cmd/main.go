@@ -1,22 +1,88 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"file-sharing/config"
+	"file-sharing/internal/cron"
+	"file-sharing/internal/lib/crypto"
 	"file-sharing/internal/routers"
+	"file-sharing/internal/services"
 	"file-sharing/internal/services/db"
+	"file-sharing/internal/storage"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 func main() {
+	cfg, err := config.Load(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Signed URLs only last as long as the process without a configured key
+	if cfg.SigningKey == "" {
+		cfg.SigningKey = crypto.CreateSecret(64)
+		log.Println("No signing-key configured, signed download URLs stop working on restart")
+	}
+
 	// Connect client
-	client := db.Connect(config.DB_PATH, true)
+	client := db.Connect(cfg.DBPath, true)
 	defer client.Close()
 
+	// Storage of uploaded content
+	st, err := storage.New(cfg)
+	if err != nil {
+		log.Fatalf("failed creating storage: %v", err)
+	}
+
+	// Start deleting expired files in background
+	fs := services.NewFile(client, st, cfg)
+	us := services.NewUpload(client, st, cfg)
+	reaper := cron.NewExpiry(cfg, fs, us, services.NewBundle(client, fs, cfg), services.NewLink(client, fs, cfg))
+	reaper.Start()
+
 	router := gin.Default()
-	r := routers.New(client)
+	// Client IP is only taken from X-Forwarded-For of trusted proxies, so rate limits can't be dodged
+	if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		log.Fatalf("invalid trusted proxies: %v", err)
+	}
+	r := routers.New(client, st, cfg)
+
+	r.UseRateLimit(router)
+	r.UseAuth(router)
 
 	r.RegisterFile(router)
+	r.RegisterUpload(router, us)
+	r.RegisterBundle(router)
+	r.RegisterUser(router)
+	r.RegisterWeb(router)
+
+	srv := &http.Server{Addr: ":" + cfg.Port, Handler: router}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("failed running server: %v", err)
+		}
+	}()
+
+	// Wait for interrupt signal to gracefully shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+
+	log.Println("Shutting down server...")
+	reaper.Stop()
 
-	router.Run(":" + config.PORT)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("failed shutting down server: %v", err)
+	}
 }
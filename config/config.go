@@ -1,18 +1,18 @@
 package config
 
 import (
+	"errors"
+	"file-sharing/internal/lib/crypto"
+	"fmt"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 )
 
 const (
-	PORT             = "3000"         // Server port
-	SAVE_SPLIT       = 10             // Filtering upload files wheter greater or lower than this variable (MB)
-	MAX_UPLOAD       = 50             // Max upload file (MB)
-	TOKEN_LENGTH     = 10             // Token length for file token
-	PAGINATION_LIMIT = 20             // Pagination limit for get many endpoints
-	DB_PATH          = "data/data.db" // Database path
-	UPLOAD_PATH      = "uploads"      // Save uploaded file path
-	DELETE_LOG_PATH  = "delete-logs"  // Path for log of deleting upload files
+	LARGE_DIR = "large" // Storage directory of filtered files
+	SMALL_DIR = "small" // Storage directory of filtered files
 
 	// DO NOT EDIT.
 
@@ -21,10 +21,194 @@ const (
 	GB = 1 << 30 // 1073741824
 )
 
-var (
-	LARGE_PATH              = filepath.Join(UPLOAD_PATH, "/large")       // Save filtered file path
-	SMALL_PATH              = filepath.Join(UPLOAD_PATH, "/small")       // Save filtered file path
-	CLEAR_LOG_PATH          = filepath.Join(DELETE_LOG_PATH, "/clear")   // Save delete log by cli
-	AUTO_DELETE_LOG_PATH    = filepath.Join(DELETE_LOG_PATH, "/auto")    // Save delete log by cron
-	REQUEST_DELETE_LOG_PATH = filepath.Join(DELETE_LOG_PATH, "/request") // Save delete log by delete request
-)
+// Config holds runtime settings, see Load for where they are read from.
+type Config struct {
+	Port            string `yaml:"port" toml:"port"`                         // Server port
+	SaveSplit       int64  `yaml:"save_split" toml:"save_split"`             // Filtering upload files wheter greater or lower than this (MB), do not change once files are stored
+	MaxUpload       int64  `yaml:"max_upload" toml:"max_upload"`             // Max upload file (MB)
+	TokenLength     int    `yaml:"token_length" toml:"token_length"`         // Token length for file token
+	SecretLength    int    `yaml:"secret_length" toml:"secret_length"`       // Token length for secret management token
+	PaginationLimit int    `yaml:"pagination_limit" toml:"pagination_limit"` // Pagination limit for get many endpoints
+	MaxPageLimit    int    `yaml:"max_page_limit" toml:"max_page_limit"`     // Max pagination limit client can choose
+	DBPath          string `yaml:"db_path" toml:"db_path"`                   // Database path
+	UploadPath      string `yaml:"upload_path" toml:"upload_path"`           // Save uploaded file path
+	DeleteLogPath   string `yaml:"delete_log_path" toml:"delete_log_path"`   // Path for log of deleting upload files
+	ExpiryInterval  int    `yaml:"expiry_interval" toml:"expiry_interval"`   // Interval of deleting expired files (minutes)
+	MaxExpiry       int    `yaml:"max_expiry" toml:"max_expiry"`             // Max expiry uploader can choose for a file (days)
+	UploadExpiry    int    `yaml:"upload_expiry" toml:"upload_expiry"`       // Expiry of idle resumable upload session (hours)
+
+	Admins []string `yaml:"admins" toml:"admins"` // Usernames allowed to list and manage every file and assign plans
+
+	Quota Quota            `yaml:"quota" toml:"quota"` // Quota of users without a plan
+	Plans map[string]Quota `yaml:"plans" toml:"plans"` // Quotas by plan name, assigned to users by admins
+
+	RateIP           RateLimit `yaml:"rate_ip" toml:"rate_ip"`                     // Requests of a client IP
+	RateToken        RateLimit `yaml:"rate_token" toml:"rate_token"`               // Requests of a share token across every client
+	RateMiss         RateLimit `yaml:"rate_miss" toml:"rate_miss"`                 // Lookups of unknown share tokens by a client IP, slowing token enumeration
+	LockoutThreshold int       `yaml:"lockout_threshold" toml:"lockout_threshold"` // Wrong passwords of a file or bundle before it is locked
+	LockoutBase      int       `yaml:"lockout_base" toml:"lockout_base"`           // First lockout (seconds), doubled by every further wrong password
+	LockoutMax       int       `yaml:"lockout_max" toml:"lockout_max"`             // Longest lockout (minutes)
+	TrustedProxies   []string  `yaml:"trusted_proxies" toml:"trusted_proxies"`     // Proxies allowed to set client IP in X-Forwarded-For
+
+	SigningKey          string `yaml:"signing_key" toml:"signing_key"`                     // Key signing download URLs, random on every start if empty
+	DownloadURLExpiry   int    `yaml:"download_url_expiry" toml:"download_url_expiry"`     // Expiry of download URL exchanged for a password (seconds)
+	DownloadClaimExpiry int    `yaml:"download_claim_expiry" toml:"download_claim_expiry"` // Expiry of claim resuming a counted download with ranges (minutes)
+
+	EncryptionKeys []string `yaml:"encryption_keys" toml:"encryption_keys"` // Master keys as "id:base64", the first wraps new data keys, content is stored in plaintext if empty
+
+	AllowedMimes []string `yaml:"allowed_mimes" toml:"allowed_mimes"` // Types of content detected on upload which are allowed, e.g. "image/*", every type if empty
+	DeniedMimes  []string `yaml:"denied_mimes" toml:"denied_mimes"`   // Types of content detected on upload which are rejected, even if allowed
+
+	StorageDriver string `yaml:"storage_driver" toml:"storage_driver"` // Storage of uploaded files, "local" (in UploadPath) or "s3"
+	S3Endpoint    string `yaml:"s3_endpoint" toml:"s3_endpoint"`       // S3-compatible endpoint, e.g. "https://s3.amazonaws.com" or "http://localhost:9000"
+	S3Region      string `yaml:"s3_region" toml:"s3_region"`           // S3 region
+	S3Bucket      string `yaml:"s3_bucket" toml:"s3_bucket"`           // S3 bucket
+	S3AccessKey   string `yaml:"s3_access_key" toml:"s3_access_key"`   // S3 credential, prefer environment over config file
+	S3SecretKey   string `yaml:"s3_secret_key" toml:"s3_secret_key"`   // S3 credential, prefer environment over config file
+	S3PathStyle   bool   `yaml:"s3_path_style" toml:"s3_path_style"`   // Address bucket in path instead of host name, required by MinIO
+}
+
+// Quota limits uploads of a user, zero means unlimited. Anonymous uploads are only limited by MaxUpload.
+type Quota struct {
+	MaxBytes    int64 `yaml:"max_bytes" toml:"max_bytes"`         // Total size of active files (MB)
+	MaxShares   int   `yaml:"max_shares" toml:"max_shares"`       // Count of active files
+	MaxFileSize int64 `yaml:"max_file_size" toml:"max_file_size"` // Size of a single file (MB)
+}
+
+// RateLimit is a token bucket refilled by Rate requests per minute up to Burst, zero rate disables it.
+type RateLimit struct {
+	Rate  int `yaml:"rate" toml:"rate"`
+	Burst int `yaml:"burst" toml:"burst"`
+}
+
+// Default returns settings used when nothing else is configured.
+func Default() *Config {
+	return &Config{
+		Port:            "3000",
+		SaveSplit:       10,
+		MaxUpload:       50,
+		TokenLength:     10,
+		SecretLength:    32,
+		PaginationLimit: 20,
+		MaxPageLimit:    100,
+		DBPath:          "data/data.db",
+		UploadPath:      "uploads",
+		DeleteLogPath:   "delete-logs",
+		ExpiryInterval:  10,
+		MaxExpiry:       30,
+		UploadExpiry:    24,
+
+		RateIP:           RateLimit{Rate: 120, Burst: 60},
+		RateToken:        RateLimit{Rate: 300, Burst: 100},
+		RateMiss:         RateLimit{Rate: 10, Burst: 20},
+		LockoutThreshold: 5,
+		LockoutBase:      30,
+		LockoutMax:       60,
+
+		DownloadURLExpiry:   300,
+		DownloadClaimExpiry: 60,
+
+		StorageDriver: "local",
+		S3Region:      "us-east-1",
+		S3PathStyle:   true,
+	}
+}
+
+// Save incomplete and temporary uploads path, always in local disk
+func (c *Config) PartialPath() string {
+	return filepath.Join(c.UploadPath, "partial")
+}
+
+// Save delete log by cli
+func (c *Config) ClearLogPath() string {
+	return filepath.Join(c.DeleteLogPath, "clear")
+}
+
+// Save delete log by cron
+func (c *Config) AutoDeleteLogPath() string {
+	return filepath.Join(c.DeleteLogPath, "auto")
+}
+
+// Save delete log by delete request
+func (c *Config) RequestDeleteLogPath() string {
+	return filepath.Join(c.DeleteLogPath, "request")
+}
+
+// IsAdmin reports whether user of username is an admin.
+func (c *Config) IsAdmin(username string) bool {
+	return slices.Contains(c.Admins, username)
+}
+
+// GetQuota returns quota of plan, users without a known plan get the default quota.
+func (c *Config) GetQuota(plan *string) Quota {
+	if plan != nil {
+		if q, ok := c.Plans[*plan]; ok {
+			return q
+		}
+	}
+	return c.Quota
+}
+
+// Validate reports every invalid setting.
+func (c *Config) Validate() error {
+	errs := []error{}
+	check := func(ok bool, format string, a ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, a...))
+		}
+	}
+
+	port, err := strconv.Atoi(c.Port)
+	check(err == nil && port > 0 && port < 1<<16, "port must be a number between 1 and 65535, got %q", c.Port)
+	check(c.SaveSplit > 0, "save-split must be greater than 0")
+	check(c.MaxUpload > 0, "max-upload must be greater than 0")
+	check(c.TokenLength >= 6, "token-length must be at least 6")
+	check(c.SecretLength >= 16, "secret-length must be at least 16")
+	check(c.PaginationLimit > 0, "pagination-limit must be greater than 0")
+	check(c.MaxPageLimit >= c.PaginationLimit, "max-page-limit must be at least pagination-limit")
+	check(c.DBPath != "", "db-path is required")
+	check(c.UploadPath != "", "upload-path is required")
+	check(c.DeleteLogPath != "", "delete-log-path is required")
+	check(c.ExpiryInterval > 0, "expiry-interval must be greater than 0")
+	check(c.MaxExpiry > 0, "max-expiry must be greater than 0")
+	check(c.UploadExpiry > 0, "upload-expiry must be greater than 0")
+
+	validQuota := func(q Quota) bool {
+		return q.MaxBytes >= 0 && q.MaxShares >= 0 && q.MaxFileSize >= 0
+	}
+	check(validQuota(c.Quota), "quota limits must not be negative")
+	for name, q := range c.Plans {
+		check(name != "", "plan name must not be empty")
+		check(validQuota(q), "quota limits of plan %q must not be negative", name)
+	}
+
+	for name, r := range map[string]RateLimit{"rate-ip": c.RateIP, "rate-token": c.RateToken, "rate-miss": c.RateMiss} {
+		check(r.Rate >= 0, "%v must not be negative", name)
+		check(r.Rate == 0 || r.Burst > 0, "%v-burst must be greater than 0", name)
+	}
+	check(c.LockoutThreshold > 0, "lockout-threshold must be greater than 0")
+	check(c.LockoutBase > 0, "lockout-base must be greater than 0")
+	check(c.LockoutMax > 0, "lockout-max must be greater than 0")
+	check(c.SigningKey == "" || len(c.SigningKey) >= 32, "signing-key must be at least 32 characters")
+	check(c.DownloadURLExpiry > 0, "download-url-expiry must be greater than 0")
+	check(c.DownloadClaimExpiry > 0, "download-claim-expiry must be greater than 0")
+	if _, err := crypto.ParseKeyring(c.EncryptionKeys); err != nil {
+		check(false, "encryption-keys: %v", err)
+	}
+	for _, m := range append(slices.Clone(c.AllowedMimes), c.DeniedMimes...) {
+		t, sub, _ := strings.Cut(m, "/")
+		check(t != "" && sub != "" && !strings.Contains(t, "*"), "mime type must look like \"type/subtype\" or \"type/*\", got %q", m)
+	}
+
+	switch c.StorageDriver {
+	case "local":
+	case "s3":
+		check(c.S3Endpoint != "", "s3-endpoint is required by s3 storage")
+		check(c.S3Bucket != "", "s3-bucket is required by s3 storage")
+		check(c.S3AccessKey != "" && c.S3SecretKey != "", "s3-access-key and s3-secret-key are required by s3 storage")
+	default:
+		check(false, "storage-driver must be \"local\" or \"s3\", got %q", c.StorageDriver)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,169 @@
+package config
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Load reads settings with precedence: defaults < config file < environment < flags.
+// Config file is given by -config flag or CONFIG_FILE, in YAML or TOML by its extension.
+// Environment variable of a flag is its upper snake case name, e.g. -max-upload is MAX_UPLOAD.
+// Settings are registered in fs along with flags defined by caller, then args are parsed.
+func Load(fs *flag.FlagSet, args []string) (*Config, error) {
+	c := Default()
+
+	file := lookupFlag(args, "config")
+	if file == "" {
+		file = os.Getenv("CONFIG_FILE")
+	}
+	if file != "" {
+		if err := c.loadFile(file); err != nil {
+			return nil, err
+		}
+	}
+
+	fs.String("config", file, "Path of YAML or TOML config file")
+	c.bindFlags(fs)
+
+	if err := loadEnv(fs); err != nil {
+		return nil, err
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config:\n%w", err)
+	}
+	return c, nil
+}
+
+func (c *Config) bindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.Port, "port", c.Port, "Server port")
+	fs.Int64Var(&c.SaveSplit, "save-split", c.SaveSplit, "Filtering upload files wheter greater or lower than this (MB)")
+	fs.Int64Var(&c.MaxUpload, "max-upload", c.MaxUpload, "Max upload file (MB)")
+	fs.IntVar(&c.TokenLength, "token-length", c.TokenLength, "Token length for file token")
+	fs.IntVar(&c.SecretLength, "secret-length", c.SecretLength, "Token length for secret management token")
+	fs.IntVar(&c.PaginationLimit, "pagination-limit", c.PaginationLimit, "Pagination limit for get many endpoints")
+	fs.IntVar(&c.MaxPageLimit, "max-page-limit", c.MaxPageLimit, "Max pagination limit client can choose")
+	fs.StringVar(&c.DBPath, "db-path", c.DBPath, "Database path")
+	fs.StringVar(&c.UploadPath, "upload-path", c.UploadPath, "Save uploaded file path")
+	fs.StringVar(&c.DeleteLogPath, "delete-log-path", c.DeleteLogPath, "Path for log of deleting upload files")
+	fs.IntVar(&c.ExpiryInterval, "expiry-interval", c.ExpiryInterval, "Interval of deleting expired files (minutes)")
+	fs.IntVar(&c.MaxExpiry, "max-expiry", c.MaxExpiry, "Max expiry uploader can choose for a file (days)")
+	fs.IntVar(&c.UploadExpiry, "upload-expiry", c.UploadExpiry, "Expiry of idle resumable upload session (hours)")
+	fs.Var((*stringList)(&c.Admins), "admins", "Comma separated usernames allowed to list and manage every file and assign plans")
+	fs.Int64Var(&c.Quota.MaxBytes, "quota-max-bytes", c.Quota.MaxBytes, "Total size of active files of a user without a plan (MB), 0 is unlimited")
+	fs.IntVar(&c.Quota.MaxShares, "quota-max-shares", c.Quota.MaxShares, "Count of active files of a user without a plan, 0 is unlimited")
+	fs.Int64Var(&c.Quota.MaxFileSize, "quota-max-file-size", c.Quota.MaxFileSize, "Size of a single file of a user without a plan (MB), 0 is unlimited")
+
+	fs.IntVar(&c.RateIP.Rate, "rate-ip", c.RateIP.Rate, "Requests per minute of a client IP, 0 disables the limit")
+	fs.IntVar(&c.RateIP.Burst, "rate-ip-burst", c.RateIP.Burst, "Requests a client IP can make at once")
+	fs.IntVar(&c.RateToken.Rate, "rate-token", c.RateToken.Rate, "Requests per minute of a share token, 0 disables the limit")
+	fs.IntVar(&c.RateToken.Burst, "rate-token-burst", c.RateToken.Burst, "Requests of a share token at once")
+	fs.IntVar(&c.RateMiss.Rate, "rate-miss", c.RateMiss.Rate, "Unknown share tokens per minute a client IP can look up, 0 disables the limit")
+	fs.IntVar(&c.RateMiss.Burst, "rate-miss-burst", c.RateMiss.Burst, "Unknown share tokens a client IP can look up at once")
+	fs.IntVar(&c.LockoutThreshold, "lockout-threshold", c.LockoutThreshold, "Wrong passwords of a file or bundle before it is locked")
+	fs.IntVar(&c.LockoutBase, "lockout-base", c.LockoutBase, "First lockout (seconds), doubled by every further wrong password")
+	fs.IntVar(&c.LockoutMax, "lockout-max", c.LockoutMax, "Longest lockout (minutes)")
+	fs.Var((*stringList)(&c.TrustedProxies), "trusted-proxies", "Comma separated proxy IPs or CIDRs allowed to set client IP in X-Forwarded-For")
+	fs.StringVar(&c.SigningKey, "signing-key", c.SigningKey, "Key signing download URLs, prefer SIGNING_KEY environment variable")
+	fs.IntVar(&c.DownloadURLExpiry, "download-url-expiry", c.DownloadURLExpiry, "Expiry of download URL exchanged for a password (seconds)")
+	fs.IntVar(&c.DownloadClaimExpiry, "download-claim-expiry", c.DownloadClaimExpiry, "Expiry of claim resuming a counted download with ranges (minutes)")
+	fs.Var((*stringList)(&c.AllowedMimes), "allowed-mimes", `Comma separated types of content allowed on upload, e.g. "image/*,application/pdf", every type if empty`)
+	fs.Var((*stringList)(&c.DeniedMimes), "denied-mimes", "Comma separated types of content rejected on upload, even if allowed")
+	fs.Var((*stringList)(&c.EncryptionKeys), "encryption-keys", `Comma separated master keys as "id:base64", the first wraps new data keys, prefer ENCRYPTION_KEYS environment variable`)
+
+	fs.StringVar(&c.StorageDriver, "storage-driver", c.StorageDriver, `Storage of uploaded files, "local" or "s3"`)
+	fs.StringVar(&c.S3Endpoint, "s3-endpoint", c.S3Endpoint, "S3-compatible endpoint")
+	fs.StringVar(&c.S3Region, "s3-region", c.S3Region, "S3 region")
+	fs.StringVar(&c.S3Bucket, "s3-bucket", c.S3Bucket, "S3 bucket")
+	fs.StringVar(&c.S3AccessKey, "s3-access-key", c.S3AccessKey, "S3 credential, prefer S3_ACCESS_KEY environment variable")
+	fs.StringVar(&c.S3SecretKey, "s3-secret-key", c.S3SecretKey, "S3 credential, prefer S3_SECRET_KEY environment variable")
+	fs.BoolVar(&c.S3PathStyle, "s3-path-style", c.S3PathStyle, "Address bucket in path instead of host name, required by MinIO")
+}
+
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalWithOptions(data, c, yaml.Strict())
+	case ".toml":
+		err = toml.NewDecoder(bytes.NewReader(data)).DisallowUnknownFields().Decode(c)
+	default:
+		return fmt.Errorf("config file %v must be .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("parsing config file %v: %w", path, err)
+	}
+	return nil
+}
+
+// stringList is a comma separated flag value.
+type stringList []string
+
+func (l *stringList) String() string {
+	if l == nil {
+		return ""
+	}
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(v string) error {
+	*l = nil
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			*l = append(*l, s)
+		}
+	}
+	return nil
+}
+
+// loadEnv sets every flag in fs which environment variable is set.
+func loadEnv(fs *flag.FlagSet) error {
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || f.Name == "config" {
+			return
+		}
+		env := strings.ToUpper(strings.ReplaceAll(f.Name, "-", "_"))
+		if v, ok := os.LookupEnv(env); ok {
+			if e := fs.Set(f.Name, v); e != nil {
+				err = fmt.Errorf("invalid %v environment variable: %w", env, e)
+			}
+		}
+	})
+	return err
+}
+
+// lookupFlag returns value of flag name in args before they are parsed, other flags are skipped without knowing their type.
+func lookupFlag(args []string, name string) string {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if a == "--" {
+			break
+		}
+		if !strings.HasPrefix(a, "-") {
+			continue
+		}
+		a = strings.TrimPrefix(strings.TrimPrefix(a, "-"), "-")
+		if v, ok := strings.CutPrefix(a, name+"="); ok {
+			return v
+		}
+		if a == name && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
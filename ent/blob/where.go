@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blob
+
+import (
+	"file-sharing/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldID, id))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldSize, v))
+}
+
+// RefCount applies equality check predicate on the "ref_count" field. It's identical to RefCountEQ.
+func RefCount(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldRefCount, v))
+}
+
+// DataKey applies equality check predicate on the "data_key" field. It's identical to DataKeyEQ.
+func DataKey(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldDataKey, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldSize, v))
+}
+
+// RefCountEQ applies the EQ predicate on the "ref_count" field.
+func RefCountEQ(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldRefCount, v))
+}
+
+// RefCountNEQ applies the NEQ predicate on the "ref_count" field.
+func RefCountNEQ(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldRefCount, v))
+}
+
+// RefCountIn applies the In predicate on the "ref_count" field.
+func RefCountIn(vs ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldRefCount, vs...))
+}
+
+// RefCountNotIn applies the NotIn predicate on the "ref_count" field.
+func RefCountNotIn(vs ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldRefCount, vs...))
+}
+
+// RefCountGT applies the GT predicate on the "ref_count" field.
+func RefCountGT(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldRefCount, v))
+}
+
+// RefCountGTE applies the GTE predicate on the "ref_count" field.
+func RefCountGTE(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldRefCount, v))
+}
+
+// RefCountLT applies the LT predicate on the "ref_count" field.
+func RefCountLT(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldRefCount, v))
+}
+
+// RefCountLTE applies the LTE predicate on the "ref_count" field.
+func RefCountLTE(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldRefCount, v))
+}
+
+// DataKeyEQ applies the EQ predicate on the "data_key" field.
+func DataKeyEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldDataKey, v))
+}
+
+// DataKeyNEQ applies the NEQ predicate on the "data_key" field.
+func DataKeyNEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldDataKey, v))
+}
+
+// DataKeyIn applies the In predicate on the "data_key" field.
+func DataKeyIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldDataKey, vs...))
+}
+
+// DataKeyNotIn applies the NotIn predicate on the "data_key" field.
+func DataKeyNotIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldDataKey, vs...))
+}
+
+// DataKeyGT applies the GT predicate on the "data_key" field.
+func DataKeyGT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldDataKey, v))
+}
+
+// DataKeyGTE applies the GTE predicate on the "data_key" field.
+func DataKeyGTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldDataKey, v))
+}
+
+// DataKeyLT applies the LT predicate on the "data_key" field.
+func DataKeyLT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldDataKey, v))
+}
+
+// DataKeyLTE applies the LTE predicate on the "data_key" field.
+func DataKeyLTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldDataKey, v))
+}
+
+// DataKeyContains applies the Contains predicate on the "data_key" field.
+func DataKeyContains(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContains(FieldDataKey, v))
+}
+
+// DataKeyHasPrefix applies the HasPrefix predicate on the "data_key" field.
+func DataKeyHasPrefix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasPrefix(FieldDataKey, v))
+}
+
+// DataKeyHasSuffix applies the HasSuffix predicate on the "data_key" field.
+func DataKeyHasSuffix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasSuffix(FieldDataKey, v))
+}
+
+// DataKeyIsNil applies the IsNil predicate on the "data_key" field.
+func DataKeyIsNil() predicate.Blob {
+	return predicate.Blob(sql.FieldIsNull(FieldDataKey))
+}
+
+// DataKeyNotNil applies the NotNil predicate on the "data_key" field.
+func DataKeyNotNil() predicate.Blob {
+	return predicate.Blob(sql.FieldNotNull(FieldDataKey))
+}
+
+// DataKeyEqualFold applies the EqualFold predicate on the "data_key" field.
+func DataKeyEqualFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldDataKey, v))
+}
+
+// DataKeyContainsFold applies the ContainsFold predicate on the "data_key" field.
+func DataKeyContainsFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldDataKey, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Blob) predicate.Blob {
+	return predicate.Blob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Blob) predicate.Blob {
+	return predicate.Blob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Blob) predicate.Blob {
+	return predicate.Blob(sql.NotPredicates(p))
+}
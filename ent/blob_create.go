@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"file-sharing/ent/blob"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlobCreate is the builder for creating a Blob entity.
+type BlobCreate struct {
+	config
+	mutation *BlobMutation
+	hooks    []Hook
+}
+
+// SetSize sets the "size" field.
+func (_c *BlobCreate) SetSize(v int64) *BlobCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetRefCount sets the "ref_count" field.
+func (_c *BlobCreate) SetRefCount(v int) *BlobCreate {
+	_c.mutation.SetRefCount(v)
+	return _c
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (_c *BlobCreate) SetNillableRefCount(v *int) *BlobCreate {
+	if v != nil {
+		_c.SetRefCount(*v)
+	}
+	return _c
+}
+
+// SetDataKey sets the "data_key" field.
+func (_c *BlobCreate) SetDataKey(v string) *BlobCreate {
+	_c.mutation.SetDataKey(v)
+	return _c
+}
+
+// SetNillableDataKey sets the "data_key" field if the given value is not nil.
+func (_c *BlobCreate) SetNillableDataKey(v *string) *BlobCreate {
+	if v != nil {
+		_c.SetDataKey(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BlobCreate) SetCreatedAt(v time.Time) *BlobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BlobCreate) SetNillableCreatedAt(v *time.Time) *BlobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BlobCreate) SetID(v string) *BlobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (_c *BlobCreate) Mutation() *BlobMutation {
+	return _c.mutation
+}
+
+// Save creates the Blob in the database.
+func (_c *BlobCreate) Save(ctx context.Context) (*Blob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BlobCreate) SaveX(ctx context.Context) *Blob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BlobCreate) defaults() {
+	if _, ok := _c.mutation.RefCount(); !ok {
+		v := blob.DefaultRefCount
+		_c.mutation.SetRefCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := blob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BlobCreate) check() error {
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Blob.size"`)}
+	}
+	if _, ok := _c.mutation.RefCount(); !ok {
+		return &ValidationError{Name: "ref_count", err: errors.New(`ent: missing required field "Blob.ref_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Blob.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BlobCreate) sqlSave(ctx context.Context) (*Blob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Blob.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BlobCreate) createSpec() (*Blob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Blob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(blob.Table, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(blob.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.RefCount(); ok {
+		_spec.SetField(blob.FieldRefCount, field.TypeInt, value)
+		_node.RefCount = value
+	}
+	if value, ok := _c.mutation.DataKey(); ok {
+		_spec.SetField(blob.FieldDataKey, field.TypeString, value)
+		_node.DataKey = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(blob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// BlobCreateBulk is the builder for creating many Blob entities in bulk.
+type BlobCreateBulk struct {
+	config
+	err      error
+	builders []*BlobCreate
+}
+
+// Save creates the Blob entities in the database.
+func (_c *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Blob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BlobCreateBulk) SaveX(ctx context.Context) []*Blob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
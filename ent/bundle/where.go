@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bundle
+
+import (
+	"file-sharing/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContainsFold(FieldID, id))
+}
+
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldPassword, v))
+}
+
+// ManageToken applies equality check predicate on the "manage_token" field. It's identical to ManageTokenEQ.
+func ManageToken(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldManageToken, v))
+}
+
+// MaxDownloads applies equality check predicate on the "max_downloads" field. It's identical to MaxDownloadsEQ.
+func MaxDownloads(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldMaxDownloads, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// FailedAttempts applies equality check predicate on the "failed_attempts" field. It's identical to FailedAttemptsEQ.
+func FailedAttempts(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldFailedAttempts, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldToken, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// DownloadCount applies equality check predicate on the "download_count" field. It's identical to DownloadCountEQ.
+func DownloadCount(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldPassword, v))
+}
+
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldPassword, v))
+}
+
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldPassword, vs...))
+}
+
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldPassword, vs...))
+}
+
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldPassword, v))
+}
+
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldPassword, v))
+}
+
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldPassword, v))
+}
+
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldPassword, v))
+}
+
+// PasswordContains applies the Contains predicate on the "password" field.
+func PasswordContains(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContains(FieldPassword, v))
+}
+
+// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
+func PasswordHasPrefix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasPrefix(FieldPassword, v))
+}
+
+// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
+func PasswordHasSuffix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasSuffix(FieldPassword, v))
+}
+
+// PasswordIsNil applies the IsNil predicate on the "password" field.
+func PasswordIsNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldIsNull(FieldPassword))
+}
+
+// PasswordNotNil applies the NotNil predicate on the "password" field.
+func PasswordNotNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotNull(FieldPassword))
+}
+
+// PasswordEqualFold applies the EqualFold predicate on the "password" field.
+func PasswordEqualFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEqualFold(FieldPassword, v))
+}
+
+// PasswordContainsFold applies the ContainsFold predicate on the "password" field.
+func PasswordContainsFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContainsFold(FieldPassword, v))
+}
+
+// ManageTokenEQ applies the EQ predicate on the "manage_token" field.
+func ManageTokenEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldManageToken, v))
+}
+
+// ManageTokenNEQ applies the NEQ predicate on the "manage_token" field.
+func ManageTokenNEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldManageToken, v))
+}
+
+// ManageTokenIn applies the In predicate on the "manage_token" field.
+func ManageTokenIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldManageToken, vs...))
+}
+
+// ManageTokenNotIn applies the NotIn predicate on the "manage_token" field.
+func ManageTokenNotIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldManageToken, vs...))
+}
+
+// ManageTokenGT applies the GT predicate on the "manage_token" field.
+func ManageTokenGT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldManageToken, v))
+}
+
+// ManageTokenGTE applies the GTE predicate on the "manage_token" field.
+func ManageTokenGTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldManageToken, v))
+}
+
+// ManageTokenLT applies the LT predicate on the "manage_token" field.
+func ManageTokenLT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldManageToken, v))
+}
+
+// ManageTokenLTE applies the LTE predicate on the "manage_token" field.
+func ManageTokenLTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldManageToken, v))
+}
+
+// ManageTokenContains applies the Contains predicate on the "manage_token" field.
+func ManageTokenContains(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContains(FieldManageToken, v))
+}
+
+// ManageTokenHasPrefix applies the HasPrefix predicate on the "manage_token" field.
+func ManageTokenHasPrefix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasPrefix(FieldManageToken, v))
+}
+
+// ManageTokenHasSuffix applies the HasSuffix predicate on the "manage_token" field.
+func ManageTokenHasSuffix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasSuffix(FieldManageToken, v))
+}
+
+// ManageTokenEqualFold applies the EqualFold predicate on the "manage_token" field.
+func ManageTokenEqualFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEqualFold(FieldManageToken, v))
+}
+
+// ManageTokenContainsFold applies the ContainsFold predicate on the "manage_token" field.
+func ManageTokenContainsFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContainsFold(FieldManageToken, v))
+}
+
+// MaxDownloadsEQ applies the EQ predicate on the "max_downloads" field.
+func MaxDownloadsEQ(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsNEQ applies the NEQ predicate on the "max_downloads" field.
+func MaxDownloadsNEQ(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsIn applies the In predicate on the "max_downloads" field.
+func MaxDownloadsIn(vs ...int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldMaxDownloads, vs...))
+}
+
+// MaxDownloadsNotIn applies the NotIn predicate on the "max_downloads" field.
+func MaxDownloadsNotIn(vs ...int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldMaxDownloads, vs...))
+}
+
+// MaxDownloadsGT applies the GT predicate on the "max_downloads" field.
+func MaxDownloadsGT(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsGTE applies the GTE predicate on the "max_downloads" field.
+func MaxDownloadsGTE(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsLT applies the LT predicate on the "max_downloads" field.
+func MaxDownloadsLT(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsLTE applies the LTE predicate on the "max_downloads" field.
+func MaxDownloadsLTE(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsIsNil applies the IsNil predicate on the "max_downloads" field.
+func MaxDownloadsIsNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldIsNull(FieldMaxDownloads))
+}
+
+// MaxDownloadsNotNil applies the NotNil predicate on the "max_downloads" field.
+func MaxDownloadsNotNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotNull(FieldMaxDownloads))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// FailedAttemptsEQ applies the EQ predicate on the "failed_attempts" field.
+func FailedAttemptsEQ(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsNEQ applies the NEQ predicate on the "failed_attempts" field.
+func FailedAttemptsNEQ(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsIn applies the In predicate on the "failed_attempts" field.
+func FailedAttemptsIn(vs ...int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldFailedAttempts, vs...))
+}
+
+// FailedAttemptsNotIn applies the NotIn predicate on the "failed_attempts" field.
+func FailedAttemptsNotIn(vs ...int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldFailedAttempts, vs...))
+}
+
+// FailedAttemptsGT applies the GT predicate on the "failed_attempts" field.
+func FailedAttemptsGT(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsGTE applies the GTE predicate on the "failed_attempts" field.
+func FailedAttemptsGTE(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsLT applies the LT predicate on the "failed_attempts" field.
+func FailedAttemptsLT(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsLTE applies the LTE predicate on the "failed_attempts" field.
+func FailedAttemptsLTE(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldFailedAttempts, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContainsFold(FieldToken, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// DownloadCountEQ applies the EQ predicate on the "download_count" field.
+func DownloadCountEQ(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountNEQ applies the NEQ predicate on the "download_count" field.
+func DownloadCountNEQ(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountIn applies the In predicate on the "download_count" field.
+func DownloadCountIn(vs ...int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountNotIn applies the NotIn predicate on the "download_count" field.
+func DownloadCountNotIn(vs ...int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountGT applies the GT predicate on the "download_count" field.
+func DownloadCountGT(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldDownloadCount, v))
+}
+
+// DownloadCountGTE applies the GTE predicate on the "download_count" field.
+func DownloadCountGTE(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldDownloadCount, v))
+}
+
+// DownloadCountLT applies the LT predicate on the "download_count" field.
+func DownloadCountLT(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldDownloadCount, v))
+}
+
+// DownloadCountLTE applies the LTE predicate on the "download_count" field.
+func DownloadCountLTE(v int) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldDownloadCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Bundle) predicate.Bundle {
+	return predicate.Bundle(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Bundle) predicate.Bundle {
+	return predicate.Bundle(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Bundle) predicate.Bundle {
+	return predicate.Bundle(sql.NotPredicates(p))
+}
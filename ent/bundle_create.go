@@ -0,0 +1,423 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"file-sharing/ent/bundle"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleCreate is the builder for creating a Bundle entity.
+type BundleCreate struct {
+	config
+	mutation *BundleMutation
+	hooks    []Hook
+}
+
+// SetPassword sets the "password" field.
+func (_c *BundleCreate) SetPassword(v string) *BundleCreate {
+	_c.mutation.SetPassword(v)
+	return _c
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (_c *BundleCreate) SetNillablePassword(v *string) *BundleCreate {
+	if v != nil {
+		_c.SetPassword(*v)
+	}
+	return _c
+}
+
+// SetManageToken sets the "manage_token" field.
+func (_c *BundleCreate) SetManageToken(v string) *BundleCreate {
+	_c.mutation.SetManageToken(v)
+	return _c
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_c *BundleCreate) SetMaxDownloads(v int) *BundleCreate {
+	_c.mutation.SetMaxDownloads(v)
+	return _c
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableMaxDownloads(v *int) *BundleCreate {
+	if v != nil {
+		_c.SetMaxDownloads(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *BundleCreate) SetOwnerID(v string) *BundleCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableOwnerID(v *string) *BundleCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (_c *BundleCreate) SetFailedAttempts(v int) *BundleCreate {
+	_c.mutation.SetFailedAttempts(v)
+	return _c
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableFailedAttempts(v *int) *BundleCreate {
+	if v != nil {
+		_c.SetFailedAttempts(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *BundleCreate) SetLockedUntil(v time.Time) *BundleCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableLockedUntil(v *time.Time) *BundleCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *BundleCreate) SetToken(v string) *BundleCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *BundleCreate) SetExpiresAt(v time.Time) *BundleCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableExpiresAt(v *time.Time) *BundleCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_c *BundleCreate) SetDownloadCount(v int) *BundleCreate {
+	_c.mutation.SetDownloadCount(v)
+	return _c
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableDownloadCount(v *int) *BundleCreate {
+	if v != nil {
+		_c.SetDownloadCount(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BundleCreate) SetCreatedAt(v time.Time) *BundleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableCreatedAt(v *time.Time) *BundleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BundleCreate) SetUpdatedAt(v time.Time) *BundleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableUpdatedAt(v *time.Time) *BundleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BundleCreate) SetID(v string) *BundleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableID(v *string) *BundleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BundleMutation object of the builder.
+func (_c *BundleCreate) Mutation() *BundleMutation {
+	return _c.mutation
+}
+
+// Save creates the Bundle in the database.
+func (_c *BundleCreate) Save(ctx context.Context) (*Bundle, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BundleCreate) SaveX(ctx context.Context) *Bundle {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BundleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BundleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BundleCreate) defaults() {
+	if _, ok := _c.mutation.FailedAttempts(); !ok {
+		v := bundle.DefaultFailedAttempts
+		_c.mutation.SetFailedAttempts(v)
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		v := bundle.DefaultExpiresAt()
+		_c.mutation.SetExpiresAt(v)
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		v := bundle.DefaultDownloadCount
+		_c.mutation.SetDownloadCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bundle.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := bundle.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := bundle.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BundleCreate) check() error {
+	if _, ok := _c.mutation.ManageToken(); !ok {
+		return &ValidationError{Name: "manage_token", err: errors.New(`ent: missing required field "Bundle.manage_token"`)}
+	}
+	if _, ok := _c.mutation.FailedAttempts(); !ok {
+		return &ValidationError{Name: "failed_attempts", err: errors.New(`ent: missing required field "Bundle.failed_attempts"`)}
+	}
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Bundle.token"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Bundle.expires_at"`)}
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		return &ValidationError{Name: "download_count", err: errors.New(`ent: missing required field "Bundle.download_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Bundle.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Bundle.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *BundleCreate) sqlSave(ctx context.Context) (*Bundle, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Bundle.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BundleCreate) createSpec() (*Bundle, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Bundle{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bundle.Table, sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Password(); ok {
+		_spec.SetField(bundle.FieldPassword, field.TypeString, value)
+		_node.Password = &value
+	}
+	if value, ok := _c.mutation.ManageToken(); ok {
+		_spec.SetField(bundle.FieldManageToken, field.TypeString, value)
+		_node.ManageToken = value
+	}
+	if value, ok := _c.mutation.MaxDownloads(); ok {
+		_spec.SetField(bundle.FieldMaxDownloads, field.TypeInt, value)
+		_node.MaxDownloads = &value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(bundle.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = &value
+	}
+	if value, ok := _c.mutation.FailedAttempts(); ok {
+		_spec.SetField(bundle.FieldFailedAttempts, field.TypeInt, value)
+		_node.FailedAttempts = value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(bundle.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(bundle.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(bundle.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.DownloadCount(); ok {
+		_spec.SetField(bundle.FieldDownloadCount, field.TypeInt, value)
+		_node.DownloadCount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bundle.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(bundle.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// BundleCreateBulk is the builder for creating many Bundle entities in bulk.
+type BundleCreateBulk struct {
+	config
+	err      error
+	builders []*BundleCreate
+}
+
+// Save creates the Bundle entities in the database.
+func (_c *BundleCreateBulk) Save(ctx context.Context) ([]*Bundle, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Bundle, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BundleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BundleCreateBulk) SaveX(ctx context.Context) []*Bundle {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BundleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BundleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
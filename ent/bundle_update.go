@@ -0,0 +1,650 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"file-sharing/ent/bundle"
+	"file-sharing/ent/predicate"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleUpdate is the builder for updating Bundle entities.
+type BundleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BundleMutation
+}
+
+// Where appends a list predicates to the BundleUpdate builder.
+func (_u *BundleUpdate) Where(ps ...predicate.Bundle) *BundleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPassword sets the "password" field.
+func (_u *BundleUpdate) SetPassword(v string) *BundleUpdate {
+	_u.mutation.SetPassword(v)
+	return _u
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillablePassword(v *string) *BundleUpdate {
+	if v != nil {
+		_u.SetPassword(*v)
+	}
+	return _u
+}
+
+// ClearPassword clears the value of the "password" field.
+func (_u *BundleUpdate) ClearPassword() *BundleUpdate {
+	_u.mutation.ClearPassword()
+	return _u
+}
+
+// SetManageToken sets the "manage_token" field.
+func (_u *BundleUpdate) SetManageToken(v string) *BundleUpdate {
+	_u.mutation.SetManageToken(v)
+	return _u
+}
+
+// SetNillableManageToken sets the "manage_token" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableManageToken(v *string) *BundleUpdate {
+	if v != nil {
+		_u.SetManageToken(*v)
+	}
+	return _u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_u *BundleUpdate) SetMaxDownloads(v int) *BundleUpdate {
+	_u.mutation.ResetMaxDownloads()
+	_u.mutation.SetMaxDownloads(v)
+	return _u
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableMaxDownloads(v *int) *BundleUpdate {
+	if v != nil {
+		_u.SetMaxDownloads(*v)
+	}
+	return _u
+}
+
+// AddMaxDownloads adds value to the "max_downloads" field.
+func (_u *BundleUpdate) AddMaxDownloads(v int) *BundleUpdate {
+	_u.mutation.AddMaxDownloads(v)
+	return _u
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (_u *BundleUpdate) ClearMaxDownloads() *BundleUpdate {
+	_u.mutation.ClearMaxDownloads()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BundleUpdate) SetOwnerID(v string) *BundleUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableOwnerID(v *string) *BundleUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BundleUpdate) ClearOwnerID() *BundleUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (_u *BundleUpdate) SetFailedAttempts(v int) *BundleUpdate {
+	_u.mutation.ResetFailedAttempts()
+	_u.mutation.SetFailedAttempts(v)
+	return _u
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableFailedAttempts(v *int) *BundleUpdate {
+	if v != nil {
+		_u.SetFailedAttempts(*v)
+	}
+	return _u
+}
+
+// AddFailedAttempts adds value to the "failed_attempts" field.
+func (_u *BundleUpdate) AddFailedAttempts(v int) *BundleUpdate {
+	_u.mutation.AddFailedAttempts(v)
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *BundleUpdate) SetLockedUntil(v time.Time) *BundleUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableLockedUntil(v *time.Time) *BundleUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *BundleUpdate) ClearLockedUntil() *BundleUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *BundleUpdate) SetToken(v string) *BundleUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableToken(v *string) *BundleUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *BundleUpdate) SetExpiresAt(v time.Time) *BundleUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableExpiresAt(v *time.Time) *BundleUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *BundleUpdate) SetDownloadCount(v int) *BundleUpdate {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableDownloadCount(v *int) *BundleUpdate {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *BundleUpdate) AddDownloadCount(v int) *BundleUpdate {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BundleUpdate) SetUpdatedAt(v time.Time) *BundleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BundleMutation object of the builder.
+func (_u *BundleUpdate) Mutation() *BundleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BundleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BundleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BundleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BundleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BundleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := bundle.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *BundleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bundle.Table, bundle.Columns, sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Password(); ok {
+		_spec.SetField(bundle.FieldPassword, field.TypeString, value)
+	}
+	if _u.mutation.PasswordCleared() {
+		_spec.ClearField(bundle.FieldPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.ManageToken(); ok {
+		_spec.SetField(bundle.FieldManageToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxDownloads(); ok {
+		_spec.SetField(bundle.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(bundle.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if _u.mutation.MaxDownloadsCleared() {
+		_spec.ClearField(bundle.FieldMaxDownloads, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bundle.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bundle.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailedAttempts(); ok {
+		_spec.SetField(bundle.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedAttempts(); ok {
+		_spec.AddField(bundle.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(bundle.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(bundle.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(bundle.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(bundle.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(bundle.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(bundle.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bundle.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bundle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BundleUpdateOne is the builder for updating a single Bundle entity.
+type BundleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BundleMutation
+}
+
+// SetPassword sets the "password" field.
+func (_u *BundleUpdateOne) SetPassword(v string) *BundleUpdateOne {
+	_u.mutation.SetPassword(v)
+	return _u
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillablePassword(v *string) *BundleUpdateOne {
+	if v != nil {
+		_u.SetPassword(*v)
+	}
+	return _u
+}
+
+// ClearPassword clears the value of the "password" field.
+func (_u *BundleUpdateOne) ClearPassword() *BundleUpdateOne {
+	_u.mutation.ClearPassword()
+	return _u
+}
+
+// SetManageToken sets the "manage_token" field.
+func (_u *BundleUpdateOne) SetManageToken(v string) *BundleUpdateOne {
+	_u.mutation.SetManageToken(v)
+	return _u
+}
+
+// SetNillableManageToken sets the "manage_token" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableManageToken(v *string) *BundleUpdateOne {
+	if v != nil {
+		_u.SetManageToken(*v)
+	}
+	return _u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_u *BundleUpdateOne) SetMaxDownloads(v int) *BundleUpdateOne {
+	_u.mutation.ResetMaxDownloads()
+	_u.mutation.SetMaxDownloads(v)
+	return _u
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableMaxDownloads(v *int) *BundleUpdateOne {
+	if v != nil {
+		_u.SetMaxDownloads(*v)
+	}
+	return _u
+}
+
+// AddMaxDownloads adds value to the "max_downloads" field.
+func (_u *BundleUpdateOne) AddMaxDownloads(v int) *BundleUpdateOne {
+	_u.mutation.AddMaxDownloads(v)
+	return _u
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (_u *BundleUpdateOne) ClearMaxDownloads() *BundleUpdateOne {
+	_u.mutation.ClearMaxDownloads()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BundleUpdateOne) SetOwnerID(v string) *BundleUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableOwnerID(v *string) *BundleUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BundleUpdateOne) ClearOwnerID() *BundleUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (_u *BundleUpdateOne) SetFailedAttempts(v int) *BundleUpdateOne {
+	_u.mutation.ResetFailedAttempts()
+	_u.mutation.SetFailedAttempts(v)
+	return _u
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableFailedAttempts(v *int) *BundleUpdateOne {
+	if v != nil {
+		_u.SetFailedAttempts(*v)
+	}
+	return _u
+}
+
+// AddFailedAttempts adds value to the "failed_attempts" field.
+func (_u *BundleUpdateOne) AddFailedAttempts(v int) *BundleUpdateOne {
+	_u.mutation.AddFailedAttempts(v)
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *BundleUpdateOne) SetLockedUntil(v time.Time) *BundleUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableLockedUntil(v *time.Time) *BundleUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *BundleUpdateOne) ClearLockedUntil() *BundleUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *BundleUpdateOne) SetToken(v string) *BundleUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableToken(v *string) *BundleUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *BundleUpdateOne) SetExpiresAt(v time.Time) *BundleUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableExpiresAt(v *time.Time) *BundleUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *BundleUpdateOne) SetDownloadCount(v int) *BundleUpdateOne {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableDownloadCount(v *int) *BundleUpdateOne {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *BundleUpdateOne) AddDownloadCount(v int) *BundleUpdateOne {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BundleUpdateOne) SetUpdatedAt(v time.Time) *BundleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BundleMutation object of the builder.
+func (_u *BundleUpdateOne) Mutation() *BundleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BundleUpdate builder.
+func (_u *BundleUpdateOne) Where(ps ...predicate.Bundle) *BundleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BundleUpdateOne) Select(field string, fields ...string) *BundleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Bundle entity.
+func (_u *BundleUpdateOne) Save(ctx context.Context) (*Bundle, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BundleUpdateOne) SaveX(ctx context.Context) *Bundle {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BundleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BundleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BundleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := bundle.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *BundleUpdateOne) sqlSave(ctx context.Context) (_node *Bundle, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bundle.Table, bundle.Columns, sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Bundle.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bundle.FieldID)
+		for _, f := range fields {
+			if !bundle.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bundle.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Password(); ok {
+		_spec.SetField(bundle.FieldPassword, field.TypeString, value)
+	}
+	if _u.mutation.PasswordCleared() {
+		_spec.ClearField(bundle.FieldPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.ManageToken(); ok {
+		_spec.SetField(bundle.FieldManageToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxDownloads(); ok {
+		_spec.SetField(bundle.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(bundle.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if _u.mutation.MaxDownloadsCleared() {
+		_spec.ClearField(bundle.FieldMaxDownloads, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bundle.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bundle.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailedAttempts(); ok {
+		_spec.SetField(bundle.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedAttempts(); ok {
+		_spec.AddField(bundle.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(bundle.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(bundle.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(bundle.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(bundle.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(bundle.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(bundle.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bundle.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Bundle{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bundle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -5,7 +5,13 @@ package ent
 import (
 	"context"
 	"errors"
+	"file-sharing/ent/apikey"
+	"file-sharing/ent/blob"
+	"file-sharing/ent/bundle"
 	"file-sharing/ent/file"
+	"file-sharing/ent/link"
+	"file-sharing/ent/upload"
+	"file-sharing/ent/user"
 	"fmt"
 	"reflect"
 	"sync"
@@ -73,7 +79,13 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			file.Table: file.ValidColumn,
+			apikey.Table: apikey.ValidColumn,
+			blob.Table:   blob.ValidColumn,
+			bundle.Table: bundle.ValidColumn,
+			file.Table:   file.ValidColumn,
+			link.Table:   link.ValidColumn,
+			upload.Table: upload.ValidColumn,
+			user.Table:   user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -23,10 +23,38 @@ type File struct {
 	FileName string `json:"file_name,omitempty"`
 	// Mime holds the value of the "mime" field.
 	Mime string `json:"mime,omitempty"`
+	// DeclaredMime holds the value of the "declared_mime" field.
+	DeclaredMime *string `json:"declared_mime,omitempty"`
+	// Sha256 holds the value of the "sha256" field.
+	Sha256 *string `json:"sha256,omitempty"`
+	// Md5 holds the value of the "md5" field.
+	Md5 *string `json:"md5,omitempty"`
 	// Password holds the value of the "password" field.
 	Password *string `json:"-"`
+	// ManageToken holds the value of the "manage_token" field.
+	ManageToken *string `json:"-"`
 	// MaxDownloads holds the value of the "max_downloads" field.
 	MaxDownloads *int `json:"max_downloads,omitempty"`
+	// BlobID holds the value of the "blob_id" field.
+	BlobID *string `json:"-"`
+	// BundleID holds the value of the "bundle_id" field.
+	BundleID *string `json:"bundle_id,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID *string `json:"-"`
+	// DataKey holds the value of the "data_key" field.
+	DataKey *string `json:"-"`
+	// Encryption holds the value of the "encryption" field.
+	Encryption *file.Encryption `json:"encryption,omitempty"`
+	// PasswordKey holds the value of the "password_key" field.
+	PasswordKey *string `json:"-"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata *string `json:"metadata,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility file.Visibility `json:"visibility,omitempty"`
+	// FailedAttempts holds the value of the "failed_attempts" field.
+	FailedAttempts int `json:"-"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"-"`
 	// Token holds the value of the "token" field.
 	Token string `json:"token,omitempty"`
 	// ExpiresAt holds the value of the "expires_at" field.
@@ -45,11 +73,11 @@ func (*File) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case file.FieldFileSize, file.FieldMaxDownloads, file.FieldDownloadCount:
+		case file.FieldFileSize, file.FieldMaxDownloads, file.FieldFailedAttempts, file.FieldDownloadCount:
 			values[i] = new(sql.NullInt64)
-		case file.FieldID, file.FieldFileName, file.FieldMime, file.FieldPassword, file.FieldToken:
+		case file.FieldID, file.FieldFileName, file.FieldMime, file.FieldDeclaredMime, file.FieldSha256, file.FieldMd5, file.FieldPassword, file.FieldManageToken, file.FieldBlobID, file.FieldBundleID, file.FieldOwnerID, file.FieldDataKey, file.FieldEncryption, file.FieldPasswordKey, file.FieldMetadata, file.FieldVisibility, file.FieldToken:
 			values[i] = new(sql.NullString)
-		case file.FieldExpiresAt, file.FieldCreatedAt, file.FieldUpdatedAt:
+		case file.FieldLockedUntil, file.FieldExpiresAt, file.FieldCreatedAt, file.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -90,6 +118,27 @@ func (_m *File) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Mime = value.String
 			}
+		case file.FieldDeclaredMime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field declared_mime", values[i])
+			} else if value.Valid {
+				_m.DeclaredMime = new(string)
+				*_m.DeclaredMime = value.String
+			}
+		case file.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				_m.Sha256 = new(string)
+				*_m.Sha256 = value.String
+			}
+		case file.FieldMd5:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field md5", values[i])
+			} else if value.Valid {
+				_m.Md5 = new(string)
+				*_m.Md5 = value.String
+			}
 		case file.FieldPassword:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field password", values[i])
@@ -97,6 +146,13 @@ func (_m *File) assignValues(columns []string, values []any) error {
 				_m.Password = new(string)
 				*_m.Password = value.String
 			}
+		case file.FieldManageToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field manage_token", values[i])
+			} else if value.Valid {
+				_m.ManageToken = new(string)
+				*_m.ManageToken = value.String
+			}
 		case file.FieldMaxDownloads:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field max_downloads", values[i])
@@ -104,6 +160,74 @@ func (_m *File) assignValues(columns []string, values []any) error {
 				_m.MaxDownloads = new(int)
 				*_m.MaxDownloads = int(value.Int64)
 			}
+		case file.FieldBlobID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blob_id", values[i])
+			} else if value.Valid {
+				_m.BlobID = new(string)
+				*_m.BlobID = value.String
+			}
+		case file.FieldBundleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bundle_id", values[i])
+			} else if value.Valid {
+				_m.BundleID = new(string)
+				*_m.BundleID = value.String
+			}
+		case file.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = new(string)
+				*_m.OwnerID = value.String
+			}
+		case file.FieldDataKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field data_key", values[i])
+			} else if value.Valid {
+				_m.DataKey = new(string)
+				*_m.DataKey = value.String
+			}
+		case file.FieldEncryption:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field encryption", values[i])
+			} else if value.Valid {
+				_m.Encryption = new(file.Encryption)
+				*_m.Encryption = file.Encryption(value.String)
+			}
+		case file.FieldPasswordKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_key", values[i])
+			} else if value.Valid {
+				_m.PasswordKey = new(string)
+				*_m.PasswordKey = value.String
+			}
+		case file.FieldMetadata:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value.Valid {
+				_m.Metadata = new(string)
+				*_m.Metadata = value.String
+			}
+		case file.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = file.Visibility(value.String)
+			}
+		case file.FieldFailedAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_attempts", values[i])
+			} else if value.Valid {
+				_m.FailedAttempts = int(value.Int64)
+			}
+		case file.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				_m.LockedUntil = new(time.Time)
+				*_m.LockedUntil = value.Time
+			}
 		case file.FieldToken:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field token", values[i])
@@ -179,13 +303,70 @@ func (_m *File) String() string {
 	builder.WriteString("mime=")
 	builder.WriteString(_m.Mime)
 	builder.WriteString(", ")
+	if v := _m.DeclaredMime; v != nil {
+		builder.WriteString("declared_mime=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Sha256; v != nil {
+		builder.WriteString("sha256=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Md5; v != nil {
+		builder.WriteString("md5=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("manage_token=<sensitive>")
+	builder.WriteString(", ")
 	if v := _m.MaxDownloads; v != nil {
 		builder.WriteString("max_downloads=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.BlobID; v != nil {
+		builder.WriteString("blob_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.BundleID; v != nil {
+		builder.WriteString("bundle_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.OwnerID; v != nil {
+		builder.WriteString("owner_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("data_key=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.Encryption; v != nil {
+		builder.WriteString("encryption=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("password_key=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.Metadata; v != nil {
+		builder.WriteString("metadata=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("failed_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailedAttempts))
+	builder.WriteString(", ")
+	if v := _m.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("token=")
 	builder.WriteString(_m.Token)
 	builder.WriteString(", ")
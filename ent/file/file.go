@@ -3,6 +3,7 @@
 package file
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -19,10 +20,38 @@ const (
 	FieldFileName = "file_name"
 	// FieldMime holds the string denoting the mime field in the database.
 	FieldMime = "mime"
+	// FieldDeclaredMime holds the string denoting the declared_mime field in the database.
+	FieldDeclaredMime = "declared_mime"
+	// FieldSha256 holds the string denoting the sha256 field in the database.
+	FieldSha256 = "sha256"
+	// FieldMd5 holds the string denoting the md5 field in the database.
+	FieldMd5 = "md5"
 	// FieldPassword holds the string denoting the password field in the database.
 	FieldPassword = "password"
+	// FieldManageToken holds the string denoting the manage_token field in the database.
+	FieldManageToken = "manage_token"
 	// FieldMaxDownloads holds the string denoting the max_downloads field in the database.
 	FieldMaxDownloads = "max_downloads"
+	// FieldBlobID holds the string denoting the blob_id field in the database.
+	FieldBlobID = "blob_id"
+	// FieldBundleID holds the string denoting the bundle_id field in the database.
+	FieldBundleID = "bundle_id"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldDataKey holds the string denoting the data_key field in the database.
+	FieldDataKey = "data_key"
+	// FieldEncryption holds the string denoting the encryption field in the database.
+	FieldEncryption = "encryption"
+	// FieldPasswordKey holds the string denoting the password_key field in the database.
+	FieldPasswordKey = "password_key"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldFailedAttempts holds the string denoting the failed_attempts field in the database.
+	FieldFailedAttempts = "failed_attempts"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
 	// FieldToken holds the string denoting the token field in the database.
 	FieldToken = "token"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
@@ -43,8 +72,22 @@ var Columns = []string{
 	FieldFileSize,
 	FieldFileName,
 	FieldMime,
+	FieldDeclaredMime,
+	FieldSha256,
+	FieldMd5,
 	FieldPassword,
+	FieldManageToken,
 	FieldMaxDownloads,
+	FieldBlobID,
+	FieldBundleID,
+	FieldOwnerID,
+	FieldDataKey,
+	FieldEncryption,
+	FieldPasswordKey,
+	FieldMetadata,
+	FieldVisibility,
+	FieldFailedAttempts,
+	FieldLockedUntil,
 	FieldToken,
 	FieldExpiresAt,
 	FieldDownloadCount,
@@ -63,8 +106,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// DefaultToken holds the default value on creation for the "token" field.
-	DefaultToken func() string
+	// DefaultFailedAttempts holds the default value on creation for the "failed_attempts" field.
+	DefaultFailedAttempts int
 	// DefaultExpiresAt holds the default value on creation for the "expires_at" field.
 	DefaultExpiresAt func() time.Time
 	// DefaultDownloadCount holds the default value on creation for the "download_count" field.
@@ -79,6 +122,55 @@ var (
 	DefaultID func() string
 )
 
+// Encryption defines the type for the "encryption" enum field.
+type Encryption string
+
+// Encryption values.
+const (
+	EncryptionPassword Encryption = "password"
+	EncryptionClient   Encryption = "client"
+)
+
+func (e Encryption) String() string {
+	return string(e)
+}
+
+// EncryptionValidator is a validator for the "encryption" field enum values. It is called by the builders before save.
+func EncryptionValidator(e Encryption) error {
+	switch e {
+	case EncryptionPassword, EncryptionClient:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for encryption field: %q", e)
+	}
+}
+
+// Visibility defines the type for the "visibility" enum field.
+type Visibility string
+
+// VisibilityUnlisted is the default value of the Visibility enum.
+const DefaultVisibility = VisibilityUnlisted
+
+// Visibility values.
+const (
+	VisibilityPublic   Visibility = "public"
+	VisibilityUnlisted Visibility = "unlisted"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityPublic, VisibilityUnlisted:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for visibility field: %q", v)
+	}
+}
+
 // OrderOption defines the ordering options for the File queries.
 type OrderOption func(*sql.Selector)
 
@@ -102,16 +194,86 @@ func ByMime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMime, opts...).ToFunc()
 }
 
+// ByDeclaredMime orders the results by the declared_mime field.
+func ByDeclaredMime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeclaredMime, opts...).ToFunc()
+}
+
+// BySha256 orders the results by the sha256 field.
+func BySha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSha256, opts...).ToFunc()
+}
+
+// ByMd5 orders the results by the md5 field.
+func ByMd5(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMd5, opts...).ToFunc()
+}
+
 // ByPassword orders the results by the password field.
 func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPassword, opts...).ToFunc()
 }
 
+// ByManageToken orders the results by the manage_token field.
+func ByManageToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManageToken, opts...).ToFunc()
+}
+
 // ByMaxDownloads orders the results by the max_downloads field.
 func ByMaxDownloads(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxDownloads, opts...).ToFunc()
 }
 
+// ByBlobID orders the results by the blob_id field.
+func ByBlobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlobID, opts...).ToFunc()
+}
+
+// ByBundleID orders the results by the bundle_id field.
+func ByBundleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBundleID, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByDataKey orders the results by the data_key field.
+func ByDataKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDataKey, opts...).ToFunc()
+}
+
+// ByEncryption orders the results by the encryption field.
+func ByEncryption(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncryption, opts...).ToFunc()
+}
+
+// ByPasswordKey orders the results by the password_key field.
+func ByPasswordKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordKey, opts...).ToFunc()
+}
+
+// ByMetadata orders the results by the metadata field.
+func ByMetadata(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetadata, opts...).ToFunc()
+}
+
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
+// ByFailedAttempts orders the results by the failed_attempts field.
+func ByFailedAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedAttempts, opts...).ToFunc()
+}
+
+// ByLockedUntil orders the results by the locked_until field.
+func ByLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedUntil, opts...).ToFunc()
+}
+
 // ByToken orders the results by the token field.
 func ByToken(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldToken, opts...).ToFunc()
@@ -79,16 +79,76 @@ func Mime(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldMime, v))
 }
 
+// DeclaredMime applies equality check predicate on the "declared_mime" field. It's identical to DeclaredMimeEQ.
+func DeclaredMime(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDeclaredMime, v))
+}
+
+// Sha256 applies equality check predicate on the "sha256" field. It's identical to Sha256EQ.
+func Sha256(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldSha256, v))
+}
+
+// Md5 applies equality check predicate on the "md5" field. It's identical to Md5EQ.
+func Md5(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldMd5, v))
+}
+
 // Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
 func Password(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldPassword, v))
 }
 
+// ManageToken applies equality check predicate on the "manage_token" field. It's identical to ManageTokenEQ.
+func ManageToken(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldManageToken, v))
+}
+
 // MaxDownloads applies equality check predicate on the "max_downloads" field. It's identical to MaxDownloadsEQ.
 func MaxDownloads(v int) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldMaxDownloads, v))
 }
 
+// BlobID applies equality check predicate on the "blob_id" field. It's identical to BlobIDEQ.
+func BlobID(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldBlobID, v))
+}
+
+// BundleID applies equality check predicate on the "bundle_id" field. It's identical to BundleIDEQ.
+func BundleID(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldBundleID, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// DataKey applies equality check predicate on the "data_key" field. It's identical to DataKeyEQ.
+func DataKey(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDataKey, v))
+}
+
+// PasswordKey applies equality check predicate on the "password_key" field. It's identical to PasswordKeyEQ.
+func PasswordKey(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldPasswordKey, v))
+}
+
+// Metadata applies equality check predicate on the "metadata" field. It's identical to MetadataEQ.
+func Metadata(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldMetadata, v))
+}
+
+// FailedAttempts applies equality check predicate on the "failed_attempts" field. It's identical to FailedAttemptsEQ.
+func FailedAttempts(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldFailedAttempts, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLockedUntil, v))
+}
+
 // Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
 func Token(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldToken, v))
@@ -284,6 +344,231 @@ func MimeContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldMime, v))
 }
 
+// DeclaredMimeEQ applies the EQ predicate on the "declared_mime" field.
+func DeclaredMimeEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDeclaredMime, v))
+}
+
+// DeclaredMimeNEQ applies the NEQ predicate on the "declared_mime" field.
+func DeclaredMimeNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldDeclaredMime, v))
+}
+
+// DeclaredMimeIn applies the In predicate on the "declared_mime" field.
+func DeclaredMimeIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldDeclaredMime, vs...))
+}
+
+// DeclaredMimeNotIn applies the NotIn predicate on the "declared_mime" field.
+func DeclaredMimeNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldDeclaredMime, vs...))
+}
+
+// DeclaredMimeGT applies the GT predicate on the "declared_mime" field.
+func DeclaredMimeGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldDeclaredMime, v))
+}
+
+// DeclaredMimeGTE applies the GTE predicate on the "declared_mime" field.
+func DeclaredMimeGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldDeclaredMime, v))
+}
+
+// DeclaredMimeLT applies the LT predicate on the "declared_mime" field.
+func DeclaredMimeLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldDeclaredMime, v))
+}
+
+// DeclaredMimeLTE applies the LTE predicate on the "declared_mime" field.
+func DeclaredMimeLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldDeclaredMime, v))
+}
+
+// DeclaredMimeContains applies the Contains predicate on the "declared_mime" field.
+func DeclaredMimeContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldDeclaredMime, v))
+}
+
+// DeclaredMimeHasPrefix applies the HasPrefix predicate on the "declared_mime" field.
+func DeclaredMimeHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldDeclaredMime, v))
+}
+
+// DeclaredMimeHasSuffix applies the HasSuffix predicate on the "declared_mime" field.
+func DeclaredMimeHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldDeclaredMime, v))
+}
+
+// DeclaredMimeIsNil applies the IsNil predicate on the "declared_mime" field.
+func DeclaredMimeIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldDeclaredMime))
+}
+
+// DeclaredMimeNotNil applies the NotNil predicate on the "declared_mime" field.
+func DeclaredMimeNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldDeclaredMime))
+}
+
+// DeclaredMimeEqualFold applies the EqualFold predicate on the "declared_mime" field.
+func DeclaredMimeEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldDeclaredMime, v))
+}
+
+// DeclaredMimeContainsFold applies the ContainsFold predicate on the "declared_mime" field.
+func DeclaredMimeContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldDeclaredMime, v))
+}
+
+// Sha256EQ applies the EQ predicate on the "sha256" field.
+func Sha256EQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldSha256, v))
+}
+
+// Sha256NEQ applies the NEQ predicate on the "sha256" field.
+func Sha256NEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldSha256, v))
+}
+
+// Sha256In applies the In predicate on the "sha256" field.
+func Sha256In(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldSha256, vs...))
+}
+
+// Sha256NotIn applies the NotIn predicate on the "sha256" field.
+func Sha256NotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldSha256, vs...))
+}
+
+// Sha256GT applies the GT predicate on the "sha256" field.
+func Sha256GT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldSha256, v))
+}
+
+// Sha256GTE applies the GTE predicate on the "sha256" field.
+func Sha256GTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldSha256, v))
+}
+
+// Sha256LT applies the LT predicate on the "sha256" field.
+func Sha256LT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldSha256, v))
+}
+
+// Sha256LTE applies the LTE predicate on the "sha256" field.
+func Sha256LTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldSha256, v))
+}
+
+// Sha256Contains applies the Contains predicate on the "sha256" field.
+func Sha256Contains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldSha256, v))
+}
+
+// Sha256HasPrefix applies the HasPrefix predicate on the "sha256" field.
+func Sha256HasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldSha256, v))
+}
+
+// Sha256HasSuffix applies the HasSuffix predicate on the "sha256" field.
+func Sha256HasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldSha256, v))
+}
+
+// Sha256IsNil applies the IsNil predicate on the "sha256" field.
+func Sha256IsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldSha256))
+}
+
+// Sha256NotNil applies the NotNil predicate on the "sha256" field.
+func Sha256NotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldSha256))
+}
+
+// Sha256EqualFold applies the EqualFold predicate on the "sha256" field.
+func Sha256EqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldSha256, v))
+}
+
+// Sha256ContainsFold applies the ContainsFold predicate on the "sha256" field.
+func Sha256ContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldSha256, v))
+}
+
+// Md5EQ applies the EQ predicate on the "md5" field.
+func Md5EQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldMd5, v))
+}
+
+// Md5NEQ applies the NEQ predicate on the "md5" field.
+func Md5NEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldMd5, v))
+}
+
+// Md5In applies the In predicate on the "md5" field.
+func Md5In(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldMd5, vs...))
+}
+
+// Md5NotIn applies the NotIn predicate on the "md5" field.
+func Md5NotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldMd5, vs...))
+}
+
+// Md5GT applies the GT predicate on the "md5" field.
+func Md5GT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldMd5, v))
+}
+
+// Md5GTE applies the GTE predicate on the "md5" field.
+func Md5GTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldMd5, v))
+}
+
+// Md5LT applies the LT predicate on the "md5" field.
+func Md5LT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldMd5, v))
+}
+
+// Md5LTE applies the LTE predicate on the "md5" field.
+func Md5LTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldMd5, v))
+}
+
+// Md5Contains applies the Contains predicate on the "md5" field.
+func Md5Contains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldMd5, v))
+}
+
+// Md5HasPrefix applies the HasPrefix predicate on the "md5" field.
+func Md5HasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldMd5, v))
+}
+
+// Md5HasSuffix applies the HasSuffix predicate on the "md5" field.
+func Md5HasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldMd5, v))
+}
+
+// Md5IsNil applies the IsNil predicate on the "md5" field.
+func Md5IsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldMd5))
+}
+
+// Md5NotNil applies the NotNil predicate on the "md5" field.
+func Md5NotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldMd5))
+}
+
+// Md5EqualFold applies the EqualFold predicate on the "md5" field.
+func Md5EqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldMd5, v))
+}
+
+// Md5ContainsFold applies the ContainsFold predicate on the "md5" field.
+func Md5ContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldMd5, v))
+}
+
 // PasswordEQ applies the EQ predicate on the "password" field.
 func PasswordEQ(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldPassword, v))
@@ -359,6 +644,81 @@ func PasswordContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldPassword, v))
 }
 
+// ManageTokenEQ applies the EQ predicate on the "manage_token" field.
+func ManageTokenEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldManageToken, v))
+}
+
+// ManageTokenNEQ applies the NEQ predicate on the "manage_token" field.
+func ManageTokenNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldManageToken, v))
+}
+
+// ManageTokenIn applies the In predicate on the "manage_token" field.
+func ManageTokenIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldManageToken, vs...))
+}
+
+// ManageTokenNotIn applies the NotIn predicate on the "manage_token" field.
+func ManageTokenNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldManageToken, vs...))
+}
+
+// ManageTokenGT applies the GT predicate on the "manage_token" field.
+func ManageTokenGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldManageToken, v))
+}
+
+// ManageTokenGTE applies the GTE predicate on the "manage_token" field.
+func ManageTokenGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldManageToken, v))
+}
+
+// ManageTokenLT applies the LT predicate on the "manage_token" field.
+func ManageTokenLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldManageToken, v))
+}
+
+// ManageTokenLTE applies the LTE predicate on the "manage_token" field.
+func ManageTokenLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldManageToken, v))
+}
+
+// ManageTokenContains applies the Contains predicate on the "manage_token" field.
+func ManageTokenContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldManageToken, v))
+}
+
+// ManageTokenHasPrefix applies the HasPrefix predicate on the "manage_token" field.
+func ManageTokenHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldManageToken, v))
+}
+
+// ManageTokenHasSuffix applies the HasSuffix predicate on the "manage_token" field.
+func ManageTokenHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldManageToken, v))
+}
+
+// ManageTokenIsNil applies the IsNil predicate on the "manage_token" field.
+func ManageTokenIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldManageToken))
+}
+
+// ManageTokenNotNil applies the NotNil predicate on the "manage_token" field.
+func ManageTokenNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldManageToken))
+}
+
+// ManageTokenEqualFold applies the EqualFold predicate on the "manage_token" field.
+func ManageTokenEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldManageToken, v))
+}
+
+// ManageTokenContainsFold applies the ContainsFold predicate on the "manage_token" field.
+func ManageTokenContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldManageToken, v))
+}
+
 // MaxDownloadsEQ applies the EQ predicate on the "max_downloads" field.
 func MaxDownloadsEQ(v int) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldMaxDownloads, v))
@@ -409,6 +769,596 @@ func MaxDownloadsNotNil() predicate.File {
 	return predicate.File(sql.FieldNotNull(FieldMaxDownloads))
 }
 
+// BlobIDEQ applies the EQ predicate on the "blob_id" field.
+func BlobIDEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldBlobID, v))
+}
+
+// BlobIDNEQ applies the NEQ predicate on the "blob_id" field.
+func BlobIDNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldBlobID, v))
+}
+
+// BlobIDIn applies the In predicate on the "blob_id" field.
+func BlobIDIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldBlobID, vs...))
+}
+
+// BlobIDNotIn applies the NotIn predicate on the "blob_id" field.
+func BlobIDNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldBlobID, vs...))
+}
+
+// BlobIDGT applies the GT predicate on the "blob_id" field.
+func BlobIDGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldBlobID, v))
+}
+
+// BlobIDGTE applies the GTE predicate on the "blob_id" field.
+func BlobIDGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldBlobID, v))
+}
+
+// BlobIDLT applies the LT predicate on the "blob_id" field.
+func BlobIDLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldBlobID, v))
+}
+
+// BlobIDLTE applies the LTE predicate on the "blob_id" field.
+func BlobIDLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldBlobID, v))
+}
+
+// BlobIDContains applies the Contains predicate on the "blob_id" field.
+func BlobIDContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldBlobID, v))
+}
+
+// BlobIDHasPrefix applies the HasPrefix predicate on the "blob_id" field.
+func BlobIDHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldBlobID, v))
+}
+
+// BlobIDHasSuffix applies the HasSuffix predicate on the "blob_id" field.
+func BlobIDHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldBlobID, v))
+}
+
+// BlobIDIsNil applies the IsNil predicate on the "blob_id" field.
+func BlobIDIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldBlobID))
+}
+
+// BlobIDNotNil applies the NotNil predicate on the "blob_id" field.
+func BlobIDNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldBlobID))
+}
+
+// BlobIDEqualFold applies the EqualFold predicate on the "blob_id" field.
+func BlobIDEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldBlobID, v))
+}
+
+// BlobIDContainsFold applies the ContainsFold predicate on the "blob_id" field.
+func BlobIDContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldBlobID, v))
+}
+
+// BundleIDEQ applies the EQ predicate on the "bundle_id" field.
+func BundleIDEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldBundleID, v))
+}
+
+// BundleIDNEQ applies the NEQ predicate on the "bundle_id" field.
+func BundleIDNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldBundleID, v))
+}
+
+// BundleIDIn applies the In predicate on the "bundle_id" field.
+func BundleIDIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldBundleID, vs...))
+}
+
+// BundleIDNotIn applies the NotIn predicate on the "bundle_id" field.
+func BundleIDNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldBundleID, vs...))
+}
+
+// BundleIDGT applies the GT predicate on the "bundle_id" field.
+func BundleIDGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldBundleID, v))
+}
+
+// BundleIDGTE applies the GTE predicate on the "bundle_id" field.
+func BundleIDGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldBundleID, v))
+}
+
+// BundleIDLT applies the LT predicate on the "bundle_id" field.
+func BundleIDLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldBundleID, v))
+}
+
+// BundleIDLTE applies the LTE predicate on the "bundle_id" field.
+func BundleIDLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldBundleID, v))
+}
+
+// BundleIDContains applies the Contains predicate on the "bundle_id" field.
+func BundleIDContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldBundleID, v))
+}
+
+// BundleIDHasPrefix applies the HasPrefix predicate on the "bundle_id" field.
+func BundleIDHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldBundleID, v))
+}
+
+// BundleIDHasSuffix applies the HasSuffix predicate on the "bundle_id" field.
+func BundleIDHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldBundleID, v))
+}
+
+// BundleIDIsNil applies the IsNil predicate on the "bundle_id" field.
+func BundleIDIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldBundleID))
+}
+
+// BundleIDNotNil applies the NotNil predicate on the "bundle_id" field.
+func BundleIDNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldBundleID))
+}
+
+// BundleIDEqualFold applies the EqualFold predicate on the "bundle_id" field.
+func BundleIDEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldBundleID, v))
+}
+
+// BundleIDContainsFold applies the ContainsFold predicate on the "bundle_id" field.
+func BundleIDContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldBundleID, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// DataKeyEQ applies the EQ predicate on the "data_key" field.
+func DataKeyEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDataKey, v))
+}
+
+// DataKeyNEQ applies the NEQ predicate on the "data_key" field.
+func DataKeyNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldDataKey, v))
+}
+
+// DataKeyIn applies the In predicate on the "data_key" field.
+func DataKeyIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldDataKey, vs...))
+}
+
+// DataKeyNotIn applies the NotIn predicate on the "data_key" field.
+func DataKeyNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldDataKey, vs...))
+}
+
+// DataKeyGT applies the GT predicate on the "data_key" field.
+func DataKeyGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldDataKey, v))
+}
+
+// DataKeyGTE applies the GTE predicate on the "data_key" field.
+func DataKeyGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldDataKey, v))
+}
+
+// DataKeyLT applies the LT predicate on the "data_key" field.
+func DataKeyLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldDataKey, v))
+}
+
+// DataKeyLTE applies the LTE predicate on the "data_key" field.
+func DataKeyLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldDataKey, v))
+}
+
+// DataKeyContains applies the Contains predicate on the "data_key" field.
+func DataKeyContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldDataKey, v))
+}
+
+// DataKeyHasPrefix applies the HasPrefix predicate on the "data_key" field.
+func DataKeyHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldDataKey, v))
+}
+
+// DataKeyHasSuffix applies the HasSuffix predicate on the "data_key" field.
+func DataKeyHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldDataKey, v))
+}
+
+// DataKeyIsNil applies the IsNil predicate on the "data_key" field.
+func DataKeyIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldDataKey))
+}
+
+// DataKeyNotNil applies the NotNil predicate on the "data_key" field.
+func DataKeyNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldDataKey))
+}
+
+// DataKeyEqualFold applies the EqualFold predicate on the "data_key" field.
+func DataKeyEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldDataKey, v))
+}
+
+// DataKeyContainsFold applies the ContainsFold predicate on the "data_key" field.
+func DataKeyContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldDataKey, v))
+}
+
+// EncryptionEQ applies the EQ predicate on the "encryption" field.
+func EncryptionEQ(v Encryption) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldEncryption, v))
+}
+
+// EncryptionNEQ applies the NEQ predicate on the "encryption" field.
+func EncryptionNEQ(v Encryption) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldEncryption, v))
+}
+
+// EncryptionIn applies the In predicate on the "encryption" field.
+func EncryptionIn(vs ...Encryption) predicate.File {
+	return predicate.File(sql.FieldIn(FieldEncryption, vs...))
+}
+
+// EncryptionNotIn applies the NotIn predicate on the "encryption" field.
+func EncryptionNotIn(vs ...Encryption) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldEncryption, vs...))
+}
+
+// EncryptionIsNil applies the IsNil predicate on the "encryption" field.
+func EncryptionIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldEncryption))
+}
+
+// EncryptionNotNil applies the NotNil predicate on the "encryption" field.
+func EncryptionNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldEncryption))
+}
+
+// PasswordKeyEQ applies the EQ predicate on the "password_key" field.
+func PasswordKeyEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldPasswordKey, v))
+}
+
+// PasswordKeyNEQ applies the NEQ predicate on the "password_key" field.
+func PasswordKeyNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldPasswordKey, v))
+}
+
+// PasswordKeyIn applies the In predicate on the "password_key" field.
+func PasswordKeyIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldPasswordKey, vs...))
+}
+
+// PasswordKeyNotIn applies the NotIn predicate on the "password_key" field.
+func PasswordKeyNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldPasswordKey, vs...))
+}
+
+// PasswordKeyGT applies the GT predicate on the "password_key" field.
+func PasswordKeyGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldPasswordKey, v))
+}
+
+// PasswordKeyGTE applies the GTE predicate on the "password_key" field.
+func PasswordKeyGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldPasswordKey, v))
+}
+
+// PasswordKeyLT applies the LT predicate on the "password_key" field.
+func PasswordKeyLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldPasswordKey, v))
+}
+
+// PasswordKeyLTE applies the LTE predicate on the "password_key" field.
+func PasswordKeyLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldPasswordKey, v))
+}
+
+// PasswordKeyContains applies the Contains predicate on the "password_key" field.
+func PasswordKeyContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldPasswordKey, v))
+}
+
+// PasswordKeyHasPrefix applies the HasPrefix predicate on the "password_key" field.
+func PasswordKeyHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldPasswordKey, v))
+}
+
+// PasswordKeyHasSuffix applies the HasSuffix predicate on the "password_key" field.
+func PasswordKeyHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldPasswordKey, v))
+}
+
+// PasswordKeyIsNil applies the IsNil predicate on the "password_key" field.
+func PasswordKeyIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldPasswordKey))
+}
+
+// PasswordKeyNotNil applies the NotNil predicate on the "password_key" field.
+func PasswordKeyNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldPasswordKey))
+}
+
+// PasswordKeyEqualFold applies the EqualFold predicate on the "password_key" field.
+func PasswordKeyEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldPasswordKey, v))
+}
+
+// PasswordKeyContainsFold applies the ContainsFold predicate on the "password_key" field.
+func PasswordKeyContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldPasswordKey, v))
+}
+
+// MetadataEQ applies the EQ predicate on the "metadata" field.
+func MetadataEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldMetadata, v))
+}
+
+// MetadataNEQ applies the NEQ predicate on the "metadata" field.
+func MetadataNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldMetadata, v))
+}
+
+// MetadataIn applies the In predicate on the "metadata" field.
+func MetadataIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldMetadata, vs...))
+}
+
+// MetadataNotIn applies the NotIn predicate on the "metadata" field.
+func MetadataNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldMetadata, vs...))
+}
+
+// MetadataGT applies the GT predicate on the "metadata" field.
+func MetadataGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldMetadata, v))
+}
+
+// MetadataGTE applies the GTE predicate on the "metadata" field.
+func MetadataGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldMetadata, v))
+}
+
+// MetadataLT applies the LT predicate on the "metadata" field.
+func MetadataLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldMetadata, v))
+}
+
+// MetadataLTE applies the LTE predicate on the "metadata" field.
+func MetadataLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldMetadata, v))
+}
+
+// MetadataContains applies the Contains predicate on the "metadata" field.
+func MetadataContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldMetadata, v))
+}
+
+// MetadataHasPrefix applies the HasPrefix predicate on the "metadata" field.
+func MetadataHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldMetadata, v))
+}
+
+// MetadataHasSuffix applies the HasSuffix predicate on the "metadata" field.
+func MetadataHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldMetadata, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldMetadata))
+}
+
+// MetadataEqualFold applies the EqualFold predicate on the "metadata" field.
+func MetadataEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldMetadata, v))
+}
+
+// MetadataContainsFold applies the ContainsFold predicate on the "metadata" field.
+func MetadataContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldMetadata, v))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.File {
+	return predicate.File(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// FailedAttemptsEQ applies the EQ predicate on the "failed_attempts" field.
+func FailedAttemptsEQ(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsNEQ applies the NEQ predicate on the "failed_attempts" field.
+func FailedAttemptsNEQ(v int) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsIn applies the In predicate on the "failed_attempts" field.
+func FailedAttemptsIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldIn(FieldFailedAttempts, vs...))
+}
+
+// FailedAttemptsNotIn applies the NotIn predicate on the "failed_attempts" field.
+func FailedAttemptsNotIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldFailedAttempts, vs...))
+}
+
+// FailedAttemptsGT applies the GT predicate on the "failed_attempts" field.
+func FailedAttemptsGT(v int) predicate.File {
+	return predicate.File(sql.FieldGT(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsGTE applies the GTE predicate on the "failed_attempts" field.
+func FailedAttemptsGTE(v int) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsLT applies the LT predicate on the "failed_attempts" field.
+func FailedAttemptsLT(v int) predicate.File {
+	return predicate.File(sql.FieldLT(FieldFailedAttempts, v))
+}
+
+// FailedAttemptsLTE applies the LTE predicate on the "failed_attempts" field.
+func FailedAttemptsLTE(v int) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldFailedAttempts, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldLockedUntil))
+}
+
 // TokenEQ applies the EQ predicate on the "token" field.
 func TokenEQ(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldToken, v))
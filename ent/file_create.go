@@ -38,6 +38,48 @@ func (_c *FileCreate) SetMime(v string) *FileCreate {
 	return _c
 }
 
+// SetDeclaredMime sets the "declared_mime" field.
+func (_c *FileCreate) SetDeclaredMime(v string) *FileCreate {
+	_c.mutation.SetDeclaredMime(v)
+	return _c
+}
+
+// SetNillableDeclaredMime sets the "declared_mime" field if the given value is not nil.
+func (_c *FileCreate) SetNillableDeclaredMime(v *string) *FileCreate {
+	if v != nil {
+		_c.SetDeclaredMime(*v)
+	}
+	return _c
+}
+
+// SetSha256 sets the "sha256" field.
+func (_c *FileCreate) SetSha256(v string) *FileCreate {
+	_c.mutation.SetSha256(v)
+	return _c
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_c *FileCreate) SetNillableSha256(v *string) *FileCreate {
+	if v != nil {
+		_c.SetSha256(*v)
+	}
+	return _c
+}
+
+// SetMd5 sets the "md5" field.
+func (_c *FileCreate) SetMd5(v string) *FileCreate {
+	_c.mutation.SetMd5(v)
+	return _c
+}
+
+// SetNillableMd5 sets the "md5" field if the given value is not nil.
+func (_c *FileCreate) SetNillableMd5(v *string) *FileCreate {
+	if v != nil {
+		_c.SetMd5(*v)
+	}
+	return _c
+}
+
 // SetPassword sets the "password" field.
 func (_c *FileCreate) SetPassword(v string) *FileCreate {
 	_c.mutation.SetPassword(v)
@@ -52,6 +94,20 @@ func (_c *FileCreate) SetNillablePassword(v *string) *FileCreate {
 	return _c
 }
 
+// SetManageToken sets the "manage_token" field.
+func (_c *FileCreate) SetManageToken(v string) *FileCreate {
+	_c.mutation.SetManageToken(v)
+	return _c
+}
+
+// SetNillableManageToken sets the "manage_token" field if the given value is not nil.
+func (_c *FileCreate) SetNillableManageToken(v *string) *FileCreate {
+	if v != nil {
+		_c.SetManageToken(*v)
+	}
+	return _c
+}
+
 // SetMaxDownloads sets the "max_downloads" field.
 func (_c *FileCreate) SetMaxDownloads(v int) *FileCreate {
 	_c.mutation.SetMaxDownloads(v)
@@ -66,20 +122,152 @@ func (_c *FileCreate) SetNillableMaxDownloads(v *int) *FileCreate {
 	return _c
 }
 
-// SetToken sets the "token" field.
-func (_c *FileCreate) SetToken(v string) *FileCreate {
-	_c.mutation.SetToken(v)
+// SetBlobID sets the "blob_id" field.
+func (_c *FileCreate) SetBlobID(v string) *FileCreate {
+	_c.mutation.SetBlobID(v)
+	return _c
+}
+
+// SetNillableBlobID sets the "blob_id" field if the given value is not nil.
+func (_c *FileCreate) SetNillableBlobID(v *string) *FileCreate {
+	if v != nil {
+		_c.SetBlobID(*v)
+	}
+	return _c
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (_c *FileCreate) SetBundleID(v string) *FileCreate {
+	_c.mutation.SetBundleID(v)
+	return _c
+}
+
+// SetNillableBundleID sets the "bundle_id" field if the given value is not nil.
+func (_c *FileCreate) SetNillableBundleID(v *string) *FileCreate {
+	if v != nil {
+		_c.SetBundleID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *FileCreate) SetOwnerID(v string) *FileCreate {
+	_c.mutation.SetOwnerID(v)
 	return _c
 }
 
-// SetNillableToken sets the "token" field if the given value is not nil.
-func (_c *FileCreate) SetNillableToken(v *string) *FileCreate {
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *FileCreate) SetNillableOwnerID(v *string) *FileCreate {
 	if v != nil {
-		_c.SetToken(*v)
+		_c.SetOwnerID(*v)
 	}
 	return _c
 }
 
+// SetDataKey sets the "data_key" field.
+func (_c *FileCreate) SetDataKey(v string) *FileCreate {
+	_c.mutation.SetDataKey(v)
+	return _c
+}
+
+// SetNillableDataKey sets the "data_key" field if the given value is not nil.
+func (_c *FileCreate) SetNillableDataKey(v *string) *FileCreate {
+	if v != nil {
+		_c.SetDataKey(*v)
+	}
+	return _c
+}
+
+// SetEncryption sets the "encryption" field.
+func (_c *FileCreate) SetEncryption(v file.Encryption) *FileCreate {
+	_c.mutation.SetEncryption(v)
+	return _c
+}
+
+// SetNillableEncryption sets the "encryption" field if the given value is not nil.
+func (_c *FileCreate) SetNillableEncryption(v *file.Encryption) *FileCreate {
+	if v != nil {
+		_c.SetEncryption(*v)
+	}
+	return _c
+}
+
+// SetPasswordKey sets the "password_key" field.
+func (_c *FileCreate) SetPasswordKey(v string) *FileCreate {
+	_c.mutation.SetPasswordKey(v)
+	return _c
+}
+
+// SetNillablePasswordKey sets the "password_key" field if the given value is not nil.
+func (_c *FileCreate) SetNillablePasswordKey(v *string) *FileCreate {
+	if v != nil {
+		_c.SetPasswordKey(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *FileCreate) SetMetadata(v string) *FileCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetNillableMetadata sets the "metadata" field if the given value is not nil.
+func (_c *FileCreate) SetNillableMetadata(v *string) *FileCreate {
+	if v != nil {
+		_c.SetMetadata(*v)
+	}
+	return _c
+}
+
+// SetVisibility sets the "visibility" field.
+func (_c *FileCreate) SetVisibility(v file.Visibility) *FileCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *FileCreate) SetNillableVisibility(v *file.Visibility) *FileCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (_c *FileCreate) SetFailedAttempts(v int) *FileCreate {
+	_c.mutation.SetFailedAttempts(v)
+	return _c
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (_c *FileCreate) SetNillableFailedAttempts(v *int) *FileCreate {
+	if v != nil {
+		_c.SetFailedAttempts(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *FileCreate) SetLockedUntil(v time.Time) *FileCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *FileCreate) SetNillableLockedUntil(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *FileCreate) SetToken(v string) *FileCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
 // SetExpiresAt sets the "expires_at" field.
 func (_c *FileCreate) SetExpiresAt(v time.Time) *FileCreate {
 	_c.mutation.SetExpiresAt(v)
@@ -185,9 +373,13 @@ func (_c *FileCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *FileCreate) defaults() {
-	if _, ok := _c.mutation.Token(); !ok {
-		v := file.DefaultToken()
-		_c.mutation.SetToken(v)
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := file.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
+	if _, ok := _c.mutation.FailedAttempts(); !ok {
+		v := file.DefaultFailedAttempts
+		_c.mutation.SetFailedAttempts(v)
 	}
 	if _, ok := _c.mutation.ExpiresAt(); !ok {
 		v := file.DefaultExpiresAt()
@@ -222,6 +414,22 @@ func (_c *FileCreate) check() error {
 	if _, ok := _c.mutation.Mime(); !ok {
 		return &ValidationError{Name: "mime", err: errors.New(`ent: missing required field "File.mime"`)}
 	}
+	if v, ok := _c.mutation.Encryption(); ok {
+		if err := file.EncryptionValidator(v); err != nil {
+			return &ValidationError{Name: "encryption", err: fmt.Errorf(`ent: validator failed for field "File.encryption": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "File.visibility"`)}
+	}
+	if v, ok := _c.mutation.Visibility(); ok {
+		if err := file.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "File.visibility": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FailedAttempts(); !ok {
+		return &ValidationError{Name: "failed_attempts", err: errors.New(`ent: missing required field "File.failed_attempts"`)}
+	}
 	if _, ok := _c.mutation.Token(); !ok {
 		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "File.token"`)}
 	}
@@ -284,14 +492,70 @@ func (_c *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldMime, field.TypeString, value)
 		_node.Mime = value
 	}
+	if value, ok := _c.mutation.DeclaredMime(); ok {
+		_spec.SetField(file.FieldDeclaredMime, field.TypeString, value)
+		_node.DeclaredMime = &value
+	}
+	if value, ok := _c.mutation.Sha256(); ok {
+		_spec.SetField(file.FieldSha256, field.TypeString, value)
+		_node.Sha256 = &value
+	}
+	if value, ok := _c.mutation.Md5(); ok {
+		_spec.SetField(file.FieldMd5, field.TypeString, value)
+		_node.Md5 = &value
+	}
 	if value, ok := _c.mutation.Password(); ok {
 		_spec.SetField(file.FieldPassword, field.TypeString, value)
 		_node.Password = &value
 	}
+	if value, ok := _c.mutation.ManageToken(); ok {
+		_spec.SetField(file.FieldManageToken, field.TypeString, value)
+		_node.ManageToken = &value
+	}
 	if value, ok := _c.mutation.MaxDownloads(); ok {
 		_spec.SetField(file.FieldMaxDownloads, field.TypeInt, value)
 		_node.MaxDownloads = &value
 	}
+	if value, ok := _c.mutation.BlobID(); ok {
+		_spec.SetField(file.FieldBlobID, field.TypeString, value)
+		_node.BlobID = &value
+	}
+	if value, ok := _c.mutation.BundleID(); ok {
+		_spec.SetField(file.FieldBundleID, field.TypeString, value)
+		_node.BundleID = &value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(file.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = &value
+	}
+	if value, ok := _c.mutation.DataKey(); ok {
+		_spec.SetField(file.FieldDataKey, field.TypeString, value)
+		_node.DataKey = &value
+	}
+	if value, ok := _c.mutation.Encryption(); ok {
+		_spec.SetField(file.FieldEncryption, field.TypeEnum, value)
+		_node.Encryption = &value
+	}
+	if value, ok := _c.mutation.PasswordKey(); ok {
+		_spec.SetField(file.FieldPasswordKey, field.TypeString, value)
+		_node.PasswordKey = &value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(file.FieldMetadata, field.TypeString, value)
+		_node.Metadata = &value
+	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(file.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := _c.mutation.FailedAttempts(); ok {
+		_spec.SetField(file.FieldFailedAttempts, field.TypeInt, value)
+		_node.FailedAttempts = value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(file.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
 	if value, ok := _c.mutation.Token(); ok {
 		_spec.SetField(file.FieldToken, field.TypeString, value)
 		_node.Token = value
@@ -77,6 +77,66 @@ func (_u *FileUpdate) SetNillableMime(v *string) *FileUpdate {
 	return _u
 }
 
+// SetDeclaredMime sets the "declared_mime" field.
+func (_u *FileUpdate) SetDeclaredMime(v string) *FileUpdate {
+	_u.mutation.SetDeclaredMime(v)
+	return _u
+}
+
+// SetNillableDeclaredMime sets the "declared_mime" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableDeclaredMime(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetDeclaredMime(*v)
+	}
+	return _u
+}
+
+// ClearDeclaredMime clears the value of the "declared_mime" field.
+func (_u *FileUpdate) ClearDeclaredMime() *FileUpdate {
+	_u.mutation.ClearDeclaredMime()
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *FileUpdate) SetSha256(v string) *FileUpdate {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableSha256(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (_u *FileUpdate) ClearSha256() *FileUpdate {
+	_u.mutation.ClearSha256()
+	return _u
+}
+
+// SetMd5 sets the "md5" field.
+func (_u *FileUpdate) SetMd5(v string) *FileUpdate {
+	_u.mutation.SetMd5(v)
+	return _u
+}
+
+// SetNillableMd5 sets the "md5" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableMd5(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetMd5(*v)
+	}
+	return _u
+}
+
+// ClearMd5 clears the value of the "md5" field.
+func (_u *FileUpdate) ClearMd5() *FileUpdate {
+	_u.mutation.ClearMd5()
+	return _u
+}
+
 // SetPassword sets the "password" field.
 func (_u *FileUpdate) SetPassword(v string) *FileUpdate {
 	_u.mutation.SetPassword(v)
@@ -97,6 +157,26 @@ func (_u *FileUpdate) ClearPassword() *FileUpdate {
 	return _u
 }
 
+// SetManageToken sets the "manage_token" field.
+func (_u *FileUpdate) SetManageToken(v string) *FileUpdate {
+	_u.mutation.SetManageToken(v)
+	return _u
+}
+
+// SetNillableManageToken sets the "manage_token" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableManageToken(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetManageToken(*v)
+	}
+	return _u
+}
+
+// ClearManageToken clears the value of the "manage_token" field.
+func (_u *FileUpdate) ClearManageToken() *FileUpdate {
+	_u.mutation.ClearManageToken()
+	return _u
+}
+
 // SetMaxDownloads sets the "max_downloads" field.
 func (_u *FileUpdate) SetMaxDownloads(v int) *FileUpdate {
 	_u.mutation.ResetMaxDownloads()
@@ -124,6 +204,201 @@ func (_u *FileUpdate) ClearMaxDownloads() *FileUpdate {
 	return _u
 }
 
+// SetBlobID sets the "blob_id" field.
+func (_u *FileUpdate) SetBlobID(v string) *FileUpdate {
+	_u.mutation.SetBlobID(v)
+	return _u
+}
+
+// SetNillableBlobID sets the "blob_id" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableBlobID(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetBlobID(*v)
+	}
+	return _u
+}
+
+// ClearBlobID clears the value of the "blob_id" field.
+func (_u *FileUpdate) ClearBlobID() *FileUpdate {
+	_u.mutation.ClearBlobID()
+	return _u
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (_u *FileUpdate) SetBundleID(v string) *FileUpdate {
+	_u.mutation.SetBundleID(v)
+	return _u
+}
+
+// SetNillableBundleID sets the "bundle_id" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableBundleID(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetBundleID(*v)
+	}
+	return _u
+}
+
+// ClearBundleID clears the value of the "bundle_id" field.
+func (_u *FileUpdate) ClearBundleID() *FileUpdate {
+	_u.mutation.ClearBundleID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *FileUpdate) SetOwnerID(v string) *FileUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableOwnerID(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *FileUpdate) ClearOwnerID() *FileUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetDataKey sets the "data_key" field.
+func (_u *FileUpdate) SetDataKey(v string) *FileUpdate {
+	_u.mutation.SetDataKey(v)
+	return _u
+}
+
+// SetNillableDataKey sets the "data_key" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableDataKey(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetDataKey(*v)
+	}
+	return _u
+}
+
+// ClearDataKey clears the value of the "data_key" field.
+func (_u *FileUpdate) ClearDataKey() *FileUpdate {
+	_u.mutation.ClearDataKey()
+	return _u
+}
+
+// SetEncryption sets the "encryption" field.
+func (_u *FileUpdate) SetEncryption(v file.Encryption) *FileUpdate {
+	_u.mutation.SetEncryption(v)
+	return _u
+}
+
+// SetNillableEncryption sets the "encryption" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableEncryption(v *file.Encryption) *FileUpdate {
+	if v != nil {
+		_u.SetEncryption(*v)
+	}
+	return _u
+}
+
+// ClearEncryption clears the value of the "encryption" field.
+func (_u *FileUpdate) ClearEncryption() *FileUpdate {
+	_u.mutation.ClearEncryption()
+	return _u
+}
+
+// SetPasswordKey sets the "password_key" field.
+func (_u *FileUpdate) SetPasswordKey(v string) *FileUpdate {
+	_u.mutation.SetPasswordKey(v)
+	return _u
+}
+
+// SetNillablePasswordKey sets the "password_key" field if the given value is not nil.
+func (_u *FileUpdate) SetNillablePasswordKey(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetPasswordKey(*v)
+	}
+	return _u
+}
+
+// ClearPasswordKey clears the value of the "password_key" field.
+func (_u *FileUpdate) ClearPasswordKey() *FileUpdate {
+	_u.mutation.ClearPasswordKey()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *FileUpdate) SetMetadata(v string) *FileUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetNillableMetadata sets the "metadata" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableMetadata(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetMetadata(*v)
+	}
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *FileUpdate) ClearMetadata() *FileUpdate {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *FileUpdate) SetVisibility(v file.Visibility) *FileUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableVisibility(v *file.Visibility) *FileUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (_u *FileUpdate) SetFailedAttempts(v int) *FileUpdate {
+	_u.mutation.ResetFailedAttempts()
+	_u.mutation.SetFailedAttempts(v)
+	return _u
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableFailedAttempts(v *int) *FileUpdate {
+	if v != nil {
+		_u.SetFailedAttempts(*v)
+	}
+	return _u
+}
+
+// AddFailedAttempts adds value to the "failed_attempts" field.
+func (_u *FileUpdate) AddFailedAttempts(v int) *FileUpdate {
+	_u.mutation.AddFailedAttempts(v)
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *FileUpdate) SetLockedUntil(v time.Time) *FileUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableLockedUntil(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *FileUpdate) ClearLockedUntil() *FileUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
 // SetToken sets the "token" field.
 func (_u *FileUpdate) SetToken(v string) *FileUpdate {
 	_u.mutation.SetToken(v)
@@ -220,7 +495,25 @@ func (_u *FileUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileUpdate) check() error {
+	if v, ok := _u.mutation.Encryption(); ok {
+		if err := file.EncryptionValidator(v); err != nil {
+			return &ValidationError{Name: "encryption", err: fmt.Errorf(`ent: validator failed for field "File.encryption": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := file.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "File.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *FileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(file.Table, file.Columns, sqlgraph.NewFieldSpec(file.FieldID, field.TypeString))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -241,12 +534,36 @@ func (_u *FileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Mime(); ok {
 		_spec.SetField(file.FieldMime, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DeclaredMime(); ok {
+		_spec.SetField(file.FieldDeclaredMime, field.TypeString, value)
+	}
+	if _u.mutation.DeclaredMimeCleared() {
+		_spec.ClearField(file.FieldDeclaredMime, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(file.FieldSha256, field.TypeString, value)
+	}
+	if _u.mutation.Sha256Cleared() {
+		_spec.ClearField(file.FieldSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.Md5(); ok {
+		_spec.SetField(file.FieldMd5, field.TypeString, value)
+	}
+	if _u.mutation.Md5Cleared() {
+		_spec.ClearField(file.FieldMd5, field.TypeString)
+	}
 	if value, ok := _u.mutation.Password(); ok {
 		_spec.SetField(file.FieldPassword, field.TypeString, value)
 	}
 	if _u.mutation.PasswordCleared() {
 		_spec.ClearField(file.FieldPassword, field.TypeString)
 	}
+	if value, ok := _u.mutation.ManageToken(); ok {
+		_spec.SetField(file.FieldManageToken, field.TypeString, value)
+	}
+	if _u.mutation.ManageTokenCleared() {
+		_spec.ClearField(file.FieldManageToken, field.TypeString)
+	}
 	if value, ok := _u.mutation.MaxDownloads(); ok {
 		_spec.SetField(file.FieldMaxDownloads, field.TypeInt, value)
 	}
@@ -256,6 +573,63 @@ func (_u *FileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MaxDownloadsCleared() {
 		_spec.ClearField(file.FieldMaxDownloads, field.TypeInt)
 	}
+	if value, ok := _u.mutation.BlobID(); ok {
+		_spec.SetField(file.FieldBlobID, field.TypeString, value)
+	}
+	if _u.mutation.BlobIDCleared() {
+		_spec.ClearField(file.FieldBlobID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BundleID(); ok {
+		_spec.SetField(file.FieldBundleID, field.TypeString, value)
+	}
+	if _u.mutation.BundleIDCleared() {
+		_spec.ClearField(file.FieldBundleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(file.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(file.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DataKey(); ok {
+		_spec.SetField(file.FieldDataKey, field.TypeString, value)
+	}
+	if _u.mutation.DataKeyCleared() {
+		_spec.ClearField(file.FieldDataKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Encryption(); ok {
+		_spec.SetField(file.FieldEncryption, field.TypeEnum, value)
+	}
+	if _u.mutation.EncryptionCleared() {
+		_spec.ClearField(file.FieldEncryption, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.PasswordKey(); ok {
+		_spec.SetField(file.FieldPasswordKey, field.TypeString, value)
+	}
+	if _u.mutation.PasswordKeyCleared() {
+		_spec.ClearField(file.FieldPasswordKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(file.FieldMetadata, field.TypeString, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(file.FieldMetadata, field.TypeString)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(file.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FailedAttempts(); ok {
+		_spec.SetField(file.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedAttempts(); ok {
+		_spec.AddField(file.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(file.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(file.FieldLockedUntil, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Token(); ok {
 		_spec.SetField(file.FieldToken, field.TypeString, value)
 	}
@@ -340,6 +714,66 @@ func (_u *FileUpdateOne) SetNillableMime(v *string) *FileUpdateOne {
 	return _u
 }
 
+// SetDeclaredMime sets the "declared_mime" field.
+func (_u *FileUpdateOne) SetDeclaredMime(v string) *FileUpdateOne {
+	_u.mutation.SetDeclaredMime(v)
+	return _u
+}
+
+// SetNillableDeclaredMime sets the "declared_mime" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableDeclaredMime(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetDeclaredMime(*v)
+	}
+	return _u
+}
+
+// ClearDeclaredMime clears the value of the "declared_mime" field.
+func (_u *FileUpdateOne) ClearDeclaredMime() *FileUpdateOne {
+	_u.mutation.ClearDeclaredMime()
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *FileUpdateOne) SetSha256(v string) *FileUpdateOne {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableSha256(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (_u *FileUpdateOne) ClearSha256() *FileUpdateOne {
+	_u.mutation.ClearSha256()
+	return _u
+}
+
+// SetMd5 sets the "md5" field.
+func (_u *FileUpdateOne) SetMd5(v string) *FileUpdateOne {
+	_u.mutation.SetMd5(v)
+	return _u
+}
+
+// SetNillableMd5 sets the "md5" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableMd5(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetMd5(*v)
+	}
+	return _u
+}
+
+// ClearMd5 clears the value of the "md5" field.
+func (_u *FileUpdateOne) ClearMd5() *FileUpdateOne {
+	_u.mutation.ClearMd5()
+	return _u
+}
+
 // SetPassword sets the "password" field.
 func (_u *FileUpdateOne) SetPassword(v string) *FileUpdateOne {
 	_u.mutation.SetPassword(v)
@@ -360,6 +794,26 @@ func (_u *FileUpdateOne) ClearPassword() *FileUpdateOne {
 	return _u
 }
 
+// SetManageToken sets the "manage_token" field.
+func (_u *FileUpdateOne) SetManageToken(v string) *FileUpdateOne {
+	_u.mutation.SetManageToken(v)
+	return _u
+}
+
+// SetNillableManageToken sets the "manage_token" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableManageToken(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetManageToken(*v)
+	}
+	return _u
+}
+
+// ClearManageToken clears the value of the "manage_token" field.
+func (_u *FileUpdateOne) ClearManageToken() *FileUpdateOne {
+	_u.mutation.ClearManageToken()
+	return _u
+}
+
 // SetMaxDownloads sets the "max_downloads" field.
 func (_u *FileUpdateOne) SetMaxDownloads(v int) *FileUpdateOne {
 	_u.mutation.ResetMaxDownloads()
@@ -387,6 +841,201 @@ func (_u *FileUpdateOne) ClearMaxDownloads() *FileUpdateOne {
 	return _u
 }
 
+// SetBlobID sets the "blob_id" field.
+func (_u *FileUpdateOne) SetBlobID(v string) *FileUpdateOne {
+	_u.mutation.SetBlobID(v)
+	return _u
+}
+
+// SetNillableBlobID sets the "blob_id" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableBlobID(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetBlobID(*v)
+	}
+	return _u
+}
+
+// ClearBlobID clears the value of the "blob_id" field.
+func (_u *FileUpdateOne) ClearBlobID() *FileUpdateOne {
+	_u.mutation.ClearBlobID()
+	return _u
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (_u *FileUpdateOne) SetBundleID(v string) *FileUpdateOne {
+	_u.mutation.SetBundleID(v)
+	return _u
+}
+
+// SetNillableBundleID sets the "bundle_id" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableBundleID(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetBundleID(*v)
+	}
+	return _u
+}
+
+// ClearBundleID clears the value of the "bundle_id" field.
+func (_u *FileUpdateOne) ClearBundleID() *FileUpdateOne {
+	_u.mutation.ClearBundleID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *FileUpdateOne) SetOwnerID(v string) *FileUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableOwnerID(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *FileUpdateOne) ClearOwnerID() *FileUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetDataKey sets the "data_key" field.
+func (_u *FileUpdateOne) SetDataKey(v string) *FileUpdateOne {
+	_u.mutation.SetDataKey(v)
+	return _u
+}
+
+// SetNillableDataKey sets the "data_key" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableDataKey(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetDataKey(*v)
+	}
+	return _u
+}
+
+// ClearDataKey clears the value of the "data_key" field.
+func (_u *FileUpdateOne) ClearDataKey() *FileUpdateOne {
+	_u.mutation.ClearDataKey()
+	return _u
+}
+
+// SetEncryption sets the "encryption" field.
+func (_u *FileUpdateOne) SetEncryption(v file.Encryption) *FileUpdateOne {
+	_u.mutation.SetEncryption(v)
+	return _u
+}
+
+// SetNillableEncryption sets the "encryption" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableEncryption(v *file.Encryption) *FileUpdateOne {
+	if v != nil {
+		_u.SetEncryption(*v)
+	}
+	return _u
+}
+
+// ClearEncryption clears the value of the "encryption" field.
+func (_u *FileUpdateOne) ClearEncryption() *FileUpdateOne {
+	_u.mutation.ClearEncryption()
+	return _u
+}
+
+// SetPasswordKey sets the "password_key" field.
+func (_u *FileUpdateOne) SetPasswordKey(v string) *FileUpdateOne {
+	_u.mutation.SetPasswordKey(v)
+	return _u
+}
+
+// SetNillablePasswordKey sets the "password_key" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillablePasswordKey(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetPasswordKey(*v)
+	}
+	return _u
+}
+
+// ClearPasswordKey clears the value of the "password_key" field.
+func (_u *FileUpdateOne) ClearPasswordKey() *FileUpdateOne {
+	_u.mutation.ClearPasswordKey()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *FileUpdateOne) SetMetadata(v string) *FileUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetNillableMetadata sets the "metadata" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableMetadata(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetMetadata(*v)
+	}
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *FileUpdateOne) ClearMetadata() *FileUpdateOne {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *FileUpdateOne) SetVisibility(v file.Visibility) *FileUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableVisibility(v *file.Visibility) *FileUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (_u *FileUpdateOne) SetFailedAttempts(v int) *FileUpdateOne {
+	_u.mutation.ResetFailedAttempts()
+	_u.mutation.SetFailedAttempts(v)
+	return _u
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableFailedAttempts(v *int) *FileUpdateOne {
+	if v != nil {
+		_u.SetFailedAttempts(*v)
+	}
+	return _u
+}
+
+// AddFailedAttempts adds value to the "failed_attempts" field.
+func (_u *FileUpdateOne) AddFailedAttempts(v int) *FileUpdateOne {
+	_u.mutation.AddFailedAttempts(v)
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *FileUpdateOne) SetLockedUntil(v time.Time) *FileUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableLockedUntil(v *time.Time) *FileUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *FileUpdateOne) ClearLockedUntil() *FileUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
 // SetToken sets the "token" field.
 func (_u *FileUpdateOne) SetToken(v string) *FileUpdateOne {
 	_u.mutation.SetToken(v)
@@ -496,7 +1145,25 @@ func (_u *FileUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileUpdateOne) check() error {
+	if v, ok := _u.mutation.Encryption(); ok {
+		if err := file.EncryptionValidator(v); err != nil {
+			return &ValidationError{Name: "encryption", err: fmt.Errorf(`ent: validator failed for field "File.encryption": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := file.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "File.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(file.Table, file.Columns, sqlgraph.NewFieldSpec(file.FieldID, field.TypeString))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -534,12 +1201,36 @@ func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	if value, ok := _u.mutation.Mime(); ok {
 		_spec.SetField(file.FieldMime, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DeclaredMime(); ok {
+		_spec.SetField(file.FieldDeclaredMime, field.TypeString, value)
+	}
+	if _u.mutation.DeclaredMimeCleared() {
+		_spec.ClearField(file.FieldDeclaredMime, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(file.FieldSha256, field.TypeString, value)
+	}
+	if _u.mutation.Sha256Cleared() {
+		_spec.ClearField(file.FieldSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.Md5(); ok {
+		_spec.SetField(file.FieldMd5, field.TypeString, value)
+	}
+	if _u.mutation.Md5Cleared() {
+		_spec.ClearField(file.FieldMd5, field.TypeString)
+	}
 	if value, ok := _u.mutation.Password(); ok {
 		_spec.SetField(file.FieldPassword, field.TypeString, value)
 	}
 	if _u.mutation.PasswordCleared() {
 		_spec.ClearField(file.FieldPassword, field.TypeString)
 	}
+	if value, ok := _u.mutation.ManageToken(); ok {
+		_spec.SetField(file.FieldManageToken, field.TypeString, value)
+	}
+	if _u.mutation.ManageTokenCleared() {
+		_spec.ClearField(file.FieldManageToken, field.TypeString)
+	}
 	if value, ok := _u.mutation.MaxDownloads(); ok {
 		_spec.SetField(file.FieldMaxDownloads, field.TypeInt, value)
 	}
@@ -549,6 +1240,63 @@ func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	if _u.mutation.MaxDownloadsCleared() {
 		_spec.ClearField(file.FieldMaxDownloads, field.TypeInt)
 	}
+	if value, ok := _u.mutation.BlobID(); ok {
+		_spec.SetField(file.FieldBlobID, field.TypeString, value)
+	}
+	if _u.mutation.BlobIDCleared() {
+		_spec.ClearField(file.FieldBlobID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BundleID(); ok {
+		_spec.SetField(file.FieldBundleID, field.TypeString, value)
+	}
+	if _u.mutation.BundleIDCleared() {
+		_spec.ClearField(file.FieldBundleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(file.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(file.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DataKey(); ok {
+		_spec.SetField(file.FieldDataKey, field.TypeString, value)
+	}
+	if _u.mutation.DataKeyCleared() {
+		_spec.ClearField(file.FieldDataKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Encryption(); ok {
+		_spec.SetField(file.FieldEncryption, field.TypeEnum, value)
+	}
+	if _u.mutation.EncryptionCleared() {
+		_spec.ClearField(file.FieldEncryption, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.PasswordKey(); ok {
+		_spec.SetField(file.FieldPasswordKey, field.TypeString, value)
+	}
+	if _u.mutation.PasswordKeyCleared() {
+		_spec.ClearField(file.FieldPasswordKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(file.FieldMetadata, field.TypeString, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(file.FieldMetadata, field.TypeString)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(file.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FailedAttempts(); ok {
+		_spec.SetField(file.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedAttempts(); ok {
+		_spec.AddField(file.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(file.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(file.FieldLockedUntil, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Token(); ok {
 		_spec.SetField(file.FieldToken, field.TypeString, value)
 	}
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"file-sharing/ent/link"
+	"file-sharing/ent/predicate"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LinkUpdate is the builder for updating Link entities.
+type LinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LinkMutation
+}
+
+// Where appends a list predicates to the LinkUpdate builder.
+func (_u *LinkUpdate) Where(ps ...predicate.Link) *LinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *LinkUpdate) SetFileID(v string) *LinkUpdate {
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableFileID(v *string) *LinkUpdate {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_u *LinkUpdate) SetMaxDownloads(v int) *LinkUpdate {
+	_u.mutation.ResetMaxDownloads()
+	_u.mutation.SetMaxDownloads(v)
+	return _u
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableMaxDownloads(v *int) *LinkUpdate {
+	if v != nil {
+		_u.SetMaxDownloads(*v)
+	}
+	return _u
+}
+
+// AddMaxDownloads adds value to the "max_downloads" field.
+func (_u *LinkUpdate) AddMaxDownloads(v int) *LinkUpdate {
+	_u.mutation.AddMaxDownloads(v)
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *LinkUpdate) SetDownloadCount(v int) *LinkUpdate {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableDownloadCount(v *int) *LinkUpdate {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *LinkUpdate) AddDownloadCount(v int) *LinkUpdate {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *LinkUpdate) SetExpiresAt(v time.Time) *LinkUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableExpiresAt(v *time.Time) *LinkUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LinkMutation object of the builder.
+func (_u *LinkUpdate) Mutation() *LinkMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(link.Table, link.Columns, sqlgraph.NewFieldSpec(link.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(link.FieldFileID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxDownloads(); ok {
+		_spec.SetField(link.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(link.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(link.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(link.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(link.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{link.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LinkUpdateOne is the builder for updating a single Link entity.
+type LinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LinkMutation
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *LinkUpdateOne) SetFileID(v string) *LinkUpdateOne {
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableFileID(v *string) *LinkUpdateOne {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_u *LinkUpdateOne) SetMaxDownloads(v int) *LinkUpdateOne {
+	_u.mutation.ResetMaxDownloads()
+	_u.mutation.SetMaxDownloads(v)
+	return _u
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableMaxDownloads(v *int) *LinkUpdateOne {
+	if v != nil {
+		_u.SetMaxDownloads(*v)
+	}
+	return _u
+}
+
+// AddMaxDownloads adds value to the "max_downloads" field.
+func (_u *LinkUpdateOne) AddMaxDownloads(v int) *LinkUpdateOne {
+	_u.mutation.AddMaxDownloads(v)
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *LinkUpdateOne) SetDownloadCount(v int) *LinkUpdateOne {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableDownloadCount(v *int) *LinkUpdateOne {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *LinkUpdateOne) AddDownloadCount(v int) *LinkUpdateOne {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *LinkUpdateOne) SetExpiresAt(v time.Time) *LinkUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableExpiresAt(v *time.Time) *LinkUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LinkMutation object of the builder.
+func (_u *LinkUpdateOne) Mutation() *LinkMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LinkUpdate builder.
+func (_u *LinkUpdateOne) Where(ps ...predicate.Link) *LinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LinkUpdateOne) Select(field string, fields ...string) *LinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Link entity.
+func (_u *LinkUpdateOne) Save(ctx context.Context) (*Link, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkUpdateOne) SaveX(ctx context.Context) *Link {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LinkUpdateOne) sqlSave(ctx context.Context) (_node *Link, err error) {
+	_spec := sqlgraph.NewUpdateSpec(link.Table, link.Columns, sqlgraph.NewFieldSpec(link.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Link.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, link.FieldID)
+		for _, f := range fields {
+			if !link.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != link.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(link.FieldFileID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxDownloads(); ok {
+		_spec.SetField(link.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(link.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(link.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(link.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(link.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &Link{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{link.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
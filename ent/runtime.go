@@ -3,8 +3,14 @@
 package ent
 
 import (
+	"file-sharing/ent/apikey"
+	"file-sharing/ent/blob"
+	"file-sharing/ent/bundle"
 	"file-sharing/ent/file"
+	"file-sharing/ent/link"
 	"file-sharing/ent/schema"
+	"file-sharing/ent/upload"
+	"file-sharing/ent/user"
 	"time"
 )
 
@@ -12,32 +18,126 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.ApiKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[6].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[5].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() string)
+	blobFields := schema.Blob{}.Fields()
+	_ = blobFields
+	// blobDescRefCount is the schema descriptor for ref_count field.
+	blobDescRefCount := blobFields[2].Descriptor()
+	// blob.DefaultRefCount holds the default value on creation for the ref_count field.
+	blob.DefaultRefCount = blobDescRefCount.Default.(int)
+	// blobDescCreatedAt is the schema descriptor for created_at field.
+	blobDescCreatedAt := blobFields[4].Descriptor()
+	// blob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blob.DefaultCreatedAt = blobDescCreatedAt.Default.(func() time.Time)
+	bundleFields := schema.Bundle{}.Fields()
+	_ = bundleFields
+	// bundleDescFailedAttempts is the schema descriptor for failed_attempts field.
+	bundleDescFailedAttempts := bundleFields[4].Descriptor()
+	// bundle.DefaultFailedAttempts holds the default value on creation for the failed_attempts field.
+	bundle.DefaultFailedAttempts = bundleDescFailedAttempts.Default.(int)
+	// bundleDescExpiresAt is the schema descriptor for expires_at field.
+	bundleDescExpiresAt := bundleFields[8].Descriptor()
+	// bundle.DefaultExpiresAt holds the default value on creation for the expires_at field.
+	bundle.DefaultExpiresAt = bundleDescExpiresAt.Default.(func() time.Time)
+	// bundleDescDownloadCount is the schema descriptor for download_count field.
+	bundleDescDownloadCount := bundleFields[9].Descriptor()
+	// bundle.DefaultDownloadCount holds the default value on creation for the download_count field.
+	bundle.DefaultDownloadCount = bundleDescDownloadCount.Default.(int)
+	// bundleDescCreatedAt is the schema descriptor for created_at field.
+	bundleDescCreatedAt := bundleFields[10].Descriptor()
+	// bundle.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bundle.DefaultCreatedAt = bundleDescCreatedAt.Default.(func() time.Time)
+	// bundleDescUpdatedAt is the schema descriptor for updated_at field.
+	bundleDescUpdatedAt := bundleFields[11].Descriptor()
+	// bundle.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	bundle.DefaultUpdatedAt = bundleDescUpdatedAt.Default.(func() time.Time)
+	// bundle.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bundle.UpdateDefaultUpdatedAt = bundleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// bundleDescID is the schema descriptor for id field.
+	bundleDescID := bundleFields[6].Descriptor()
+	// bundle.DefaultID holds the default value on creation for the id field.
+	bundle.DefaultID = bundleDescID.Default.(func() string)
 	fileFields := schema.File{}.Fields()
 	_ = fileFields
-	// fileDescToken is the schema descriptor for token field.
-	fileDescToken := fileFields[6].Descriptor()
-	// file.DefaultToken holds the default value on creation for the token field.
-	file.DefaultToken = fileDescToken.Default.(func() string)
+	// fileDescFailedAttempts is the schema descriptor for failed_attempts field.
+	fileDescFailedAttempts := fileFields[17].Descriptor()
+	// file.DefaultFailedAttempts holds the default value on creation for the failed_attempts field.
+	file.DefaultFailedAttempts = fileDescFailedAttempts.Default.(int)
 	// fileDescExpiresAt is the schema descriptor for expires_at field.
-	fileDescExpiresAt := fileFields[7].Descriptor()
+	fileDescExpiresAt := fileFields[21].Descriptor()
 	// file.DefaultExpiresAt holds the default value on creation for the expires_at field.
 	file.DefaultExpiresAt = fileDescExpiresAt.Default.(func() time.Time)
 	// fileDescDownloadCount is the schema descriptor for download_count field.
-	fileDescDownloadCount := fileFields[8].Descriptor()
+	fileDescDownloadCount := fileFields[22].Descriptor()
 	// file.DefaultDownloadCount holds the default value on creation for the download_count field.
 	file.DefaultDownloadCount = fileDescDownloadCount.Default.(int)
 	// fileDescCreatedAt is the schema descriptor for created_at field.
-	fileDescCreatedAt := fileFields[9].Descriptor()
+	fileDescCreatedAt := fileFields[23].Descriptor()
 	// file.DefaultCreatedAt holds the default value on creation for the created_at field.
 	file.DefaultCreatedAt = fileDescCreatedAt.Default.(func() time.Time)
 	// fileDescUpdatedAt is the schema descriptor for updated_at field.
-	fileDescUpdatedAt := fileFields[10].Descriptor()
+	fileDescUpdatedAt := fileFields[24].Descriptor()
 	// file.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	file.DefaultUpdatedAt = fileDescUpdatedAt.Default.(func() time.Time)
 	// file.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	file.UpdateDefaultUpdatedAt = fileDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// fileDescID is the schema descriptor for id field.
-	fileDescID := fileFields[5].Descriptor()
+	fileDescID := fileFields[19].Descriptor()
 	// file.DefaultID holds the default value on creation for the id field.
 	file.DefaultID = fileDescID.Default.(func() string)
+	linkFields := schema.Link{}.Fields()
+	_ = linkFields
+	// linkDescDownloadCount is the schema descriptor for download_count field.
+	linkDescDownloadCount := linkFields[2].Descriptor()
+	// link.DefaultDownloadCount holds the default value on creation for the download_count field.
+	link.DefaultDownloadCount = linkDescDownloadCount.Default.(int)
+	// linkDescCreatedAt is the schema descriptor for created_at field.
+	linkDescCreatedAt := linkFields[5].Descriptor()
+	// link.DefaultCreatedAt holds the default value on creation for the created_at field.
+	link.DefaultCreatedAt = linkDescCreatedAt.Default.(func() time.Time)
+	uploadFields := schema.Upload{}.Fields()
+	_ = uploadFields
+	// uploadDescUploadOffset is the schema descriptor for upload_offset field.
+	uploadDescUploadOffset := uploadFields[1].Descriptor()
+	// upload.DefaultUploadOffset holds the default value on creation for the upload_offset field.
+	upload.DefaultUploadOffset = uploadDescUploadOffset.Default.(int64)
+	// uploadDescCreatedAt is the schema descriptor for created_at field.
+	uploadDescCreatedAt := uploadFields[14].Descriptor()
+	// upload.DefaultCreatedAt holds the default value on creation for the created_at field.
+	upload.DefaultCreatedAt = uploadDescCreatedAt.Default.(func() time.Time)
+	// uploadDescUpdatedAt is the schema descriptor for updated_at field.
+	uploadDescUpdatedAt := uploadFields[15].Descriptor()
+	// upload.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	upload.DefaultUpdatedAt = uploadDescUpdatedAt.Default.(func() time.Time)
+	// upload.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	upload.UpdateDefaultUpdatedAt = uploadDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// uploadDescID is the schema descriptor for id field.
+	uploadDescID := uploadFields[12].Descriptor()
+	// upload.DefaultID holds the default value on creation for the id field.
+	upload.DefaultID = uploadDescID.Default.(func() string)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[4].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[5].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[3].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() string)
 }
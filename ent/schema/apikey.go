@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ApiKey authenticates requests of its user, only SHA-256 hash of the key is stored.
+type ApiKey struct {
+	ent.Schema
+}
+
+func (ApiKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id"),
+		field.String("name").Optional(),
+		field.String("key_hash").Sensitive(),
+		field.String("prefix"), // First characters of the key to tell keys apart
+		field.Time("last_used_at").Optional().Nillable(),
+
+		field.String("id").DefaultFunc(func() string {
+			return uuid.New().String()
+		}).Unique(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (ApiKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key_hash").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,23 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Blob is stored file content addressed by its SHA-256, shared by every File with the same content.
+type Blob struct {
+	ent.Schema
+}
+
+func (Blob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.Int64("size").Immutable(),
+		field.Int("ref_count").Default(0),
+		field.String("data_key").Optional().Nillable().Sensitive(), // Wrapped key of encrypted content, nil for plaintext
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Bundle groups files uploaded together under one share, its members are files with its bundle_id.
+type Bundle struct {
+	ent.Schema
+}
+
+func (Bundle) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("password").Optional().Nillable().Sensitive(),
+		field.String("manage_token").Sensitive(),
+		field.Int("max_downloads").Optional().Nillable(),
+		field.String("owner_id").Optional().Nillable().StructTag(`json:"-"`),
+		field.Int("failed_attempts").Default(0).StructTag(`json:"-"`),          // Wrong passwords in a row
+		field.Time("locked_until").Optional().Nillable().StructTag(`json:"-"`), // Downloads are refused until then after too many wrong passwords
+
+		field.String("id").DefaultFunc(func() string {
+			return uuid.New().String()
+		}).Unique(),
+		field.String("token"),
+		field.Time("expires_at").Default(func() time.Time {
+			return time.Now().AddDate(0, 0, 7)
+		}),
+		field.Int("download_count").Default(0),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Bundle) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token"),
+	}
+}
@@ -1,7 +1,6 @@
 package schema
 
 import (
-	"file-sharing/internal/lib/crypto"
 	"time"
 
 	"entgo.io/ent"
@@ -18,15 +17,29 @@ func (File) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("file_size"),
 		field.String("file_name"),
-		field.String("mime"),
+		field.String("mime"),                                // Detected from content, or declared by client for files uploaded before detection
+		field.String("declared_mime").Optional().Nillable(), // Content-Type claimed by client
+		field.String("sha256").Optional().Nillable(),
+		field.String("md5").Optional().Nillable(),
 
 		field.String("password").Optional().Nillable().Sensitive(),
+		field.String("manage_token").Optional().Nillable().Sensitive(),
 		field.Int("max_downloads").Optional().Nillable(),
+		field.String("blob_id").Optional().Nillable().StructTag(`json:"-"`),
+		field.String("bundle_id").Optional().Nillable(),
+		field.String("owner_id").Optional().Nillable().StructTag(`json:"-"`),
+		field.String("data_key").Optional().Nillable().Sensitive(),                  // Wrapped key of encrypted content, only for files stored before blobs
+		field.Enum("encryption").Values("password", "client").Optional().Nillable(), // Content is only decryptable with the share password or a key kept by clients, nil if only encrypted at rest
+		field.String("password_key").Optional().Nillable().Sensitive(),              // Data key wrapped by a key derived from the password
+		field.String("metadata").Optional().Nillable(),                              // File name and MIME type encrypted by client, opaque to server
+		field.Enum("visibility").Values("public", "unlisted").Default("unlisted"),   // Only public files appear in public listing
+		field.Int("failed_attempts").Default(0).StructTag(`json:"-"`),               // Wrong passwords in a row
+		field.Time("locked_until").Optional().Nillable().StructTag(`json:"-"`),      // Downloads are refused until then after too many wrong passwords
 
 		field.String("id").DefaultFunc(func() string {
 			return uuid.New().String()
 		}).Unique(),
-		field.String("token").DefaultFunc(crypto.CreateToken),
+		field.String("token"),
 		field.Time("expires_at").Default(func() time.Time {
 			return time.Now().AddDate(0, 0, 7)
 		}),
@@ -39,5 +52,8 @@ func (File) Fields() []ent.Field {
 func (File) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("token"),
+		index.Fields("blob_id"),
+		index.Fields("bundle_id"),
+		index.Fields("owner_id"),
 	}
 }
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Link counts downloads of a presigned URL limited to a number of downloads, URLs without a limit have no row.
+type Link struct {
+	ent.Schema
+}
+
+func (Link) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("file_id"),
+		field.Int("max_downloads"),
+		field.Int("download_count").Default(0),
+		field.Time("expires_at"),
+
+		field.String("id").Unique(), // Nonce of the presigned URL
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (Link) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("file_id"),
+	}
+}
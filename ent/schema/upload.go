@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Upload is a resumable upload session, it becomes a File once all bytes are received.
+type Upload struct {
+	ent.Schema
+}
+
+func (Upload) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("upload_length"),
+		field.Int64("upload_offset").Default(0),
+		field.String("file_name"),
+		field.String("mime"),
+
+		field.String("password").Optional().Nillable().Sensitive(),
+		field.String("manage_token").Sensitive(),
+		field.Int("max_downloads").Optional().Nillable(),
+		field.Time("file_expires_at").Optional().Nillable(),
+		field.String("expected_sha256").Optional().Nillable(),
+		field.String("expected_md5").Optional().Nillable(),
+		field.String("owner_id").Optional().Nillable(),
+		field.String("visibility").Optional().Nillable(),
+
+		field.String("id").DefaultFunc(func() string {
+			return uuid.New().String()
+		}).Unique(),
+		field.String("token"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
@@ -12,8 +12,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
+	// Bundle is the client for interacting with the Bundle builders.
+	Bundle *BundleClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// Link is the client for interacting with the Link builders.
+	Link *LinkClient
+	// Upload is the client for interacting with the Upload builders.
+	Upload *UploadClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +157,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApiKey = NewApiKeyClient(tx.config)
+	tx.Blob = NewBlobClient(tx.config)
+	tx.Bundle = NewBundleClient(tx.config)
 	tx.File = NewFileClient(tx.config)
+	tx.Link = NewLinkClient(tx.config)
+	tx.Upload = NewUploadClient(tx.config)
+	tx.User = NewUserClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +173,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: File.QueryXXX(), the query will be executed
+// applies a query, for example: ApiKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"file-sharing/ent/upload"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Upload is the model entity for the Upload schema.
+type Upload struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UploadLength holds the value of the "upload_length" field.
+	UploadLength int64 `json:"upload_length,omitempty"`
+	// UploadOffset holds the value of the "upload_offset" field.
+	UploadOffset int64 `json:"upload_offset,omitempty"`
+	// FileName holds the value of the "file_name" field.
+	FileName string `json:"file_name,omitempty"`
+	// Mime holds the value of the "mime" field.
+	Mime string `json:"mime,omitempty"`
+	// Password holds the value of the "password" field.
+	Password *string `json:"-"`
+	// ManageToken holds the value of the "manage_token" field.
+	ManageToken string `json:"-"`
+	// MaxDownloads holds the value of the "max_downloads" field.
+	MaxDownloads *int `json:"max_downloads,omitempty"`
+	// FileExpiresAt holds the value of the "file_expires_at" field.
+	FileExpiresAt *time.Time `json:"file_expires_at,omitempty"`
+	// ExpectedSha256 holds the value of the "expected_sha256" field.
+	ExpectedSha256 *string `json:"expected_sha256,omitempty"`
+	// ExpectedMd5 holds the value of the "expected_md5" field.
+	ExpectedMd5 *string `json:"expected_md5,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID *string `json:"owner_id,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility *string `json:"visibility,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Upload) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case upload.FieldUploadLength, upload.FieldUploadOffset, upload.FieldMaxDownloads:
+			values[i] = new(sql.NullInt64)
+		case upload.FieldID, upload.FieldFileName, upload.FieldMime, upload.FieldPassword, upload.FieldManageToken, upload.FieldExpectedSha256, upload.FieldExpectedMd5, upload.FieldOwnerID, upload.FieldVisibility, upload.FieldToken:
+			values[i] = new(sql.NullString)
+		case upload.FieldFileExpiresAt, upload.FieldCreatedAt, upload.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Upload fields.
+func (_m *Upload) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case upload.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case upload.FieldUploadLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_length", values[i])
+			} else if value.Valid {
+				_m.UploadLength = value.Int64
+			}
+		case upload.FieldUploadOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_offset", values[i])
+			} else if value.Valid {
+				_m.UploadOffset = value.Int64
+			}
+		case upload.FieldFileName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_name", values[i])
+			} else if value.Valid {
+				_m.FileName = value.String
+			}
+		case upload.FieldMime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime", values[i])
+			} else if value.Valid {
+				_m.Mime = value.String
+			}
+		case upload.FieldPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value.Valid {
+				_m.Password = new(string)
+				*_m.Password = value.String
+			}
+		case upload.FieldManageToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field manage_token", values[i])
+			} else if value.Valid {
+				_m.ManageToken = value.String
+			}
+		case upload.FieldMaxDownloads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_downloads", values[i])
+			} else if value.Valid {
+				_m.MaxDownloads = new(int)
+				*_m.MaxDownloads = int(value.Int64)
+			}
+		case upload.FieldFileExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field file_expires_at", values[i])
+			} else if value.Valid {
+				_m.FileExpiresAt = new(time.Time)
+				*_m.FileExpiresAt = value.Time
+			}
+		case upload.FieldExpectedSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_sha256", values[i])
+			} else if value.Valid {
+				_m.ExpectedSha256 = new(string)
+				*_m.ExpectedSha256 = value.String
+			}
+		case upload.FieldExpectedMd5:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_md5", values[i])
+			} else if value.Valid {
+				_m.ExpectedMd5 = new(string)
+				*_m.ExpectedMd5 = value.String
+			}
+		case upload.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = new(string)
+				*_m.OwnerID = value.String
+			}
+		case upload.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = new(string)
+				*_m.Visibility = value.String
+			}
+		case upload.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case upload.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case upload.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Upload.
+// This includes values selected through modifiers, order, etc.
+func (_m *Upload) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Upload.
+// Note that you need to call Upload.Unwrap() before calling this method if this Upload
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Upload) Update() *UploadUpdateOne {
+	return NewUploadClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Upload entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Upload) Unwrap() *Upload {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Upload is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Upload) String() string {
+	var builder strings.Builder
+	builder.WriteString("Upload(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("upload_length=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UploadLength))
+	builder.WriteString(", ")
+	builder.WriteString("upload_offset=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UploadOffset))
+	builder.WriteString(", ")
+	builder.WriteString("file_name=")
+	builder.WriteString(_m.FileName)
+	builder.WriteString(", ")
+	builder.WriteString("mime=")
+	builder.WriteString(_m.Mime)
+	builder.WriteString(", ")
+	builder.WriteString("password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("manage_token=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.MaxDownloads; v != nil {
+		builder.WriteString("max_downloads=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FileExpiresAt; v != nil {
+		builder.WriteString("file_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpectedSha256; v != nil {
+		builder.WriteString("expected_sha256=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpectedMd5; v != nil {
+		builder.WriteString("expected_md5=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.OwnerID; v != nil {
+		builder.WriteString("owner_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Visibility; v != nil {
+		builder.WriteString("visibility=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(_m.Token)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Uploads is a parsable slice of Upload.
+type Uploads []*Upload
@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package upload
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the upload type in the database.
+	Label = "upload"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUploadLength holds the string denoting the upload_length field in the database.
+	FieldUploadLength = "upload_length"
+	// FieldUploadOffset holds the string denoting the upload_offset field in the database.
+	FieldUploadOffset = "upload_offset"
+	// FieldFileName holds the string denoting the file_name field in the database.
+	FieldFileName = "file_name"
+	// FieldMime holds the string denoting the mime field in the database.
+	FieldMime = "mime"
+	// FieldPassword holds the string denoting the password field in the database.
+	FieldPassword = "password"
+	// FieldManageToken holds the string denoting the manage_token field in the database.
+	FieldManageToken = "manage_token"
+	// FieldMaxDownloads holds the string denoting the max_downloads field in the database.
+	FieldMaxDownloads = "max_downloads"
+	// FieldFileExpiresAt holds the string denoting the file_expires_at field in the database.
+	FieldFileExpiresAt = "file_expires_at"
+	// FieldExpectedSha256 holds the string denoting the expected_sha256 field in the database.
+	FieldExpectedSha256 = "expected_sha256"
+	// FieldExpectedMd5 holds the string denoting the expected_md5 field in the database.
+	FieldExpectedMd5 = "expected_md5"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the upload in the database.
+	Table = "uploads"
+)
+
+// Columns holds all SQL columns for upload fields.
+var Columns = []string{
+	FieldID,
+	FieldUploadLength,
+	FieldUploadOffset,
+	FieldFileName,
+	FieldMime,
+	FieldPassword,
+	FieldManageToken,
+	FieldMaxDownloads,
+	FieldFileExpiresAt,
+	FieldExpectedSha256,
+	FieldExpectedMd5,
+	FieldOwnerID,
+	FieldVisibility,
+	FieldToken,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUploadOffset holds the default value on creation for the "upload_offset" field.
+	DefaultUploadOffset int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Upload queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUploadLength orders the results by the upload_length field.
+func ByUploadLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadLength, opts...).ToFunc()
+}
+
+// ByUploadOffset orders the results by the upload_offset field.
+func ByUploadOffset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadOffset, opts...).ToFunc()
+}
+
+// ByFileName orders the results by the file_name field.
+func ByFileName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileName, opts...).ToFunc()
+}
+
+// ByMime orders the results by the mime field.
+func ByMime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMime, opts...).ToFunc()
+}
+
+// ByPassword orders the results by the password field.
+func ByPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassword, opts...).ToFunc()
+}
+
+// ByManageToken orders the results by the manage_token field.
+func ByManageToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManageToken, opts...).ToFunc()
+}
+
+// ByMaxDownloads orders the results by the max_downloads field.
+func ByMaxDownloads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDownloads, opts...).ToFunc()
+}
+
+// ByFileExpiresAt orders the results by the file_expires_at field.
+func ByFileExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileExpiresAt, opts...).ToFunc()
+}
+
+// ByExpectedSha256 orders the results by the expected_sha256 field.
+func ByExpectedSha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedSha256, opts...).ToFunc()
+}
+
+// ByExpectedMd5 orders the results by the expected_md5 field.
+func ByExpectedMd5(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedMd5, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1050 @@
+// Code generated by ent, DO NOT EDIT.
+
+package upload
+
+import (
+	"file-sharing/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldID, id))
+}
+
+// UploadLength applies equality check predicate on the "upload_length" field. It's identical to UploadLengthEQ.
+func UploadLength(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldUploadLength, v))
+}
+
+// UploadOffset applies equality check predicate on the "upload_offset" field. It's identical to UploadOffsetEQ.
+func UploadOffset(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldUploadOffset, v))
+}
+
+// FileName applies equality check predicate on the "file_name" field. It's identical to FileNameEQ.
+func FileName(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldFileName, v))
+}
+
+// Mime applies equality check predicate on the "mime" field. It's identical to MimeEQ.
+func Mime(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldMime, v))
+}
+
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldPassword, v))
+}
+
+// ManageToken applies equality check predicate on the "manage_token" field. It's identical to ManageTokenEQ.
+func ManageToken(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldManageToken, v))
+}
+
+// MaxDownloads applies equality check predicate on the "max_downloads" field. It's identical to MaxDownloadsEQ.
+func MaxDownloads(v int) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldMaxDownloads, v))
+}
+
+// FileExpiresAt applies equality check predicate on the "file_expires_at" field. It's identical to FileExpiresAtEQ.
+func FileExpiresAt(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldFileExpiresAt, v))
+}
+
+// ExpectedSha256 applies equality check predicate on the "expected_sha256" field. It's identical to ExpectedSha256EQ.
+func ExpectedSha256(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldExpectedSha256, v))
+}
+
+// ExpectedMd5 applies equality check predicate on the "expected_md5" field. It's identical to ExpectedMd5EQ.
+func ExpectedMd5(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldExpectedMd5, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// Visibility applies equality check predicate on the "visibility" field. It's identical to VisibilityEQ.
+func Visibility(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldVisibility, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldToken, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UploadLengthEQ applies the EQ predicate on the "upload_length" field.
+func UploadLengthEQ(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldUploadLength, v))
+}
+
+// UploadLengthNEQ applies the NEQ predicate on the "upload_length" field.
+func UploadLengthNEQ(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldUploadLength, v))
+}
+
+// UploadLengthIn applies the In predicate on the "upload_length" field.
+func UploadLengthIn(vs ...int64) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldUploadLength, vs...))
+}
+
+// UploadLengthNotIn applies the NotIn predicate on the "upload_length" field.
+func UploadLengthNotIn(vs ...int64) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldUploadLength, vs...))
+}
+
+// UploadLengthGT applies the GT predicate on the "upload_length" field.
+func UploadLengthGT(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldUploadLength, v))
+}
+
+// UploadLengthGTE applies the GTE predicate on the "upload_length" field.
+func UploadLengthGTE(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldUploadLength, v))
+}
+
+// UploadLengthLT applies the LT predicate on the "upload_length" field.
+func UploadLengthLT(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldUploadLength, v))
+}
+
+// UploadLengthLTE applies the LTE predicate on the "upload_length" field.
+func UploadLengthLTE(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldUploadLength, v))
+}
+
+// UploadOffsetEQ applies the EQ predicate on the "upload_offset" field.
+func UploadOffsetEQ(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldUploadOffset, v))
+}
+
+// UploadOffsetNEQ applies the NEQ predicate on the "upload_offset" field.
+func UploadOffsetNEQ(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldUploadOffset, v))
+}
+
+// UploadOffsetIn applies the In predicate on the "upload_offset" field.
+func UploadOffsetIn(vs ...int64) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldUploadOffset, vs...))
+}
+
+// UploadOffsetNotIn applies the NotIn predicate on the "upload_offset" field.
+func UploadOffsetNotIn(vs ...int64) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldUploadOffset, vs...))
+}
+
+// UploadOffsetGT applies the GT predicate on the "upload_offset" field.
+func UploadOffsetGT(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldUploadOffset, v))
+}
+
+// UploadOffsetGTE applies the GTE predicate on the "upload_offset" field.
+func UploadOffsetGTE(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldUploadOffset, v))
+}
+
+// UploadOffsetLT applies the LT predicate on the "upload_offset" field.
+func UploadOffsetLT(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldUploadOffset, v))
+}
+
+// UploadOffsetLTE applies the LTE predicate on the "upload_offset" field.
+func UploadOffsetLTE(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldUploadOffset, v))
+}
+
+// FileNameEQ applies the EQ predicate on the "file_name" field.
+func FileNameEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldFileName, v))
+}
+
+// FileNameNEQ applies the NEQ predicate on the "file_name" field.
+func FileNameNEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldFileName, v))
+}
+
+// FileNameIn applies the In predicate on the "file_name" field.
+func FileNameIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldFileName, vs...))
+}
+
+// FileNameNotIn applies the NotIn predicate on the "file_name" field.
+func FileNameNotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldFileName, vs...))
+}
+
+// FileNameGT applies the GT predicate on the "file_name" field.
+func FileNameGT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldFileName, v))
+}
+
+// FileNameGTE applies the GTE predicate on the "file_name" field.
+func FileNameGTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldFileName, v))
+}
+
+// FileNameLT applies the LT predicate on the "file_name" field.
+func FileNameLT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldFileName, v))
+}
+
+// FileNameLTE applies the LTE predicate on the "file_name" field.
+func FileNameLTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldFileName, v))
+}
+
+// FileNameContains applies the Contains predicate on the "file_name" field.
+func FileNameContains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldFileName, v))
+}
+
+// FileNameHasPrefix applies the HasPrefix predicate on the "file_name" field.
+func FileNameHasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldFileName, v))
+}
+
+// FileNameHasSuffix applies the HasSuffix predicate on the "file_name" field.
+func FileNameHasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldFileName, v))
+}
+
+// FileNameEqualFold applies the EqualFold predicate on the "file_name" field.
+func FileNameEqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldFileName, v))
+}
+
+// FileNameContainsFold applies the ContainsFold predicate on the "file_name" field.
+func FileNameContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldFileName, v))
+}
+
+// MimeEQ applies the EQ predicate on the "mime" field.
+func MimeEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldMime, v))
+}
+
+// MimeNEQ applies the NEQ predicate on the "mime" field.
+func MimeNEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldMime, v))
+}
+
+// MimeIn applies the In predicate on the "mime" field.
+func MimeIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldMime, vs...))
+}
+
+// MimeNotIn applies the NotIn predicate on the "mime" field.
+func MimeNotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldMime, vs...))
+}
+
+// MimeGT applies the GT predicate on the "mime" field.
+func MimeGT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldMime, v))
+}
+
+// MimeGTE applies the GTE predicate on the "mime" field.
+func MimeGTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldMime, v))
+}
+
+// MimeLT applies the LT predicate on the "mime" field.
+func MimeLT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldMime, v))
+}
+
+// MimeLTE applies the LTE predicate on the "mime" field.
+func MimeLTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldMime, v))
+}
+
+// MimeContains applies the Contains predicate on the "mime" field.
+func MimeContains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldMime, v))
+}
+
+// MimeHasPrefix applies the HasPrefix predicate on the "mime" field.
+func MimeHasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldMime, v))
+}
+
+// MimeHasSuffix applies the HasSuffix predicate on the "mime" field.
+func MimeHasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldMime, v))
+}
+
+// MimeEqualFold applies the EqualFold predicate on the "mime" field.
+func MimeEqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldMime, v))
+}
+
+// MimeContainsFold applies the ContainsFold predicate on the "mime" field.
+func MimeContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldMime, v))
+}
+
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldPassword, v))
+}
+
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldPassword, v))
+}
+
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldPassword, vs...))
+}
+
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldPassword, vs...))
+}
+
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldPassword, v))
+}
+
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldPassword, v))
+}
+
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldPassword, v))
+}
+
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldPassword, v))
+}
+
+// PasswordContains applies the Contains predicate on the "password" field.
+func PasswordContains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldPassword, v))
+}
+
+// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
+func PasswordHasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldPassword, v))
+}
+
+// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
+func PasswordHasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldPassword, v))
+}
+
+// PasswordIsNil applies the IsNil predicate on the "password" field.
+func PasswordIsNil() predicate.Upload {
+	return predicate.Upload(sql.FieldIsNull(FieldPassword))
+}
+
+// PasswordNotNil applies the NotNil predicate on the "password" field.
+func PasswordNotNil() predicate.Upload {
+	return predicate.Upload(sql.FieldNotNull(FieldPassword))
+}
+
+// PasswordEqualFold applies the EqualFold predicate on the "password" field.
+func PasswordEqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldPassword, v))
+}
+
+// PasswordContainsFold applies the ContainsFold predicate on the "password" field.
+func PasswordContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldPassword, v))
+}
+
+// ManageTokenEQ applies the EQ predicate on the "manage_token" field.
+func ManageTokenEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldManageToken, v))
+}
+
+// ManageTokenNEQ applies the NEQ predicate on the "manage_token" field.
+func ManageTokenNEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldManageToken, v))
+}
+
+// ManageTokenIn applies the In predicate on the "manage_token" field.
+func ManageTokenIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldManageToken, vs...))
+}
+
+// ManageTokenNotIn applies the NotIn predicate on the "manage_token" field.
+func ManageTokenNotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldManageToken, vs...))
+}
+
+// ManageTokenGT applies the GT predicate on the "manage_token" field.
+func ManageTokenGT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldManageToken, v))
+}
+
+// ManageTokenGTE applies the GTE predicate on the "manage_token" field.
+func ManageTokenGTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldManageToken, v))
+}
+
+// ManageTokenLT applies the LT predicate on the "manage_token" field.
+func ManageTokenLT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldManageToken, v))
+}
+
+// ManageTokenLTE applies the LTE predicate on the "manage_token" field.
+func ManageTokenLTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldManageToken, v))
+}
+
+// ManageTokenContains applies the Contains predicate on the "manage_token" field.
+func ManageTokenContains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldManageToken, v))
+}
+
+// ManageTokenHasPrefix applies the HasPrefix predicate on the "manage_token" field.
+func ManageTokenHasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldManageToken, v))
+}
+
+// ManageTokenHasSuffix applies the HasSuffix predicate on the "manage_token" field.
+func ManageTokenHasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldManageToken, v))
+}
+
+// ManageTokenEqualFold applies the EqualFold predicate on the "manage_token" field.
+func ManageTokenEqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldManageToken, v))
+}
+
+// ManageTokenContainsFold applies the ContainsFold predicate on the "manage_token" field.
+func ManageTokenContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldManageToken, v))
+}
+
+// MaxDownloadsEQ applies the EQ predicate on the "max_downloads" field.
+func MaxDownloadsEQ(v int) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsNEQ applies the NEQ predicate on the "max_downloads" field.
+func MaxDownloadsNEQ(v int) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsIn applies the In predicate on the "max_downloads" field.
+func MaxDownloadsIn(vs ...int) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldMaxDownloads, vs...))
+}
+
+// MaxDownloadsNotIn applies the NotIn predicate on the "max_downloads" field.
+func MaxDownloadsNotIn(vs ...int) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldMaxDownloads, vs...))
+}
+
+// MaxDownloadsGT applies the GT predicate on the "max_downloads" field.
+func MaxDownloadsGT(v int) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsGTE applies the GTE predicate on the "max_downloads" field.
+func MaxDownloadsGTE(v int) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsLT applies the LT predicate on the "max_downloads" field.
+func MaxDownloadsLT(v int) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsLTE applies the LTE predicate on the "max_downloads" field.
+func MaxDownloadsLTE(v int) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsIsNil applies the IsNil predicate on the "max_downloads" field.
+func MaxDownloadsIsNil() predicate.Upload {
+	return predicate.Upload(sql.FieldIsNull(FieldMaxDownloads))
+}
+
+// MaxDownloadsNotNil applies the NotNil predicate on the "max_downloads" field.
+func MaxDownloadsNotNil() predicate.Upload {
+	return predicate.Upload(sql.FieldNotNull(FieldMaxDownloads))
+}
+
+// FileExpiresAtEQ applies the EQ predicate on the "file_expires_at" field.
+func FileExpiresAtEQ(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldFileExpiresAt, v))
+}
+
+// FileExpiresAtNEQ applies the NEQ predicate on the "file_expires_at" field.
+func FileExpiresAtNEQ(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldFileExpiresAt, v))
+}
+
+// FileExpiresAtIn applies the In predicate on the "file_expires_at" field.
+func FileExpiresAtIn(vs ...time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldFileExpiresAt, vs...))
+}
+
+// FileExpiresAtNotIn applies the NotIn predicate on the "file_expires_at" field.
+func FileExpiresAtNotIn(vs ...time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldFileExpiresAt, vs...))
+}
+
+// FileExpiresAtGT applies the GT predicate on the "file_expires_at" field.
+func FileExpiresAtGT(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldFileExpiresAt, v))
+}
+
+// FileExpiresAtGTE applies the GTE predicate on the "file_expires_at" field.
+func FileExpiresAtGTE(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldFileExpiresAt, v))
+}
+
+// FileExpiresAtLT applies the LT predicate on the "file_expires_at" field.
+func FileExpiresAtLT(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldFileExpiresAt, v))
+}
+
+// FileExpiresAtLTE applies the LTE predicate on the "file_expires_at" field.
+func FileExpiresAtLTE(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldFileExpiresAt, v))
+}
+
+// FileExpiresAtIsNil applies the IsNil predicate on the "file_expires_at" field.
+func FileExpiresAtIsNil() predicate.Upload {
+	return predicate.Upload(sql.FieldIsNull(FieldFileExpiresAt))
+}
+
+// FileExpiresAtNotNil applies the NotNil predicate on the "file_expires_at" field.
+func FileExpiresAtNotNil() predicate.Upload {
+	return predicate.Upload(sql.FieldNotNull(FieldFileExpiresAt))
+}
+
+// ExpectedSha256EQ applies the EQ predicate on the "expected_sha256" field.
+func ExpectedSha256EQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldExpectedSha256, v))
+}
+
+// ExpectedSha256NEQ applies the NEQ predicate on the "expected_sha256" field.
+func ExpectedSha256NEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldExpectedSha256, v))
+}
+
+// ExpectedSha256In applies the In predicate on the "expected_sha256" field.
+func ExpectedSha256In(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldExpectedSha256, vs...))
+}
+
+// ExpectedSha256NotIn applies the NotIn predicate on the "expected_sha256" field.
+func ExpectedSha256NotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldExpectedSha256, vs...))
+}
+
+// ExpectedSha256GT applies the GT predicate on the "expected_sha256" field.
+func ExpectedSha256GT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldExpectedSha256, v))
+}
+
+// ExpectedSha256GTE applies the GTE predicate on the "expected_sha256" field.
+func ExpectedSha256GTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldExpectedSha256, v))
+}
+
+// ExpectedSha256LT applies the LT predicate on the "expected_sha256" field.
+func ExpectedSha256LT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldExpectedSha256, v))
+}
+
+// ExpectedSha256LTE applies the LTE predicate on the "expected_sha256" field.
+func ExpectedSha256LTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldExpectedSha256, v))
+}
+
+// ExpectedSha256Contains applies the Contains predicate on the "expected_sha256" field.
+func ExpectedSha256Contains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldExpectedSha256, v))
+}
+
+// ExpectedSha256HasPrefix applies the HasPrefix predicate on the "expected_sha256" field.
+func ExpectedSha256HasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldExpectedSha256, v))
+}
+
+// ExpectedSha256HasSuffix applies the HasSuffix predicate on the "expected_sha256" field.
+func ExpectedSha256HasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldExpectedSha256, v))
+}
+
+// ExpectedSha256IsNil applies the IsNil predicate on the "expected_sha256" field.
+func ExpectedSha256IsNil() predicate.Upload {
+	return predicate.Upload(sql.FieldIsNull(FieldExpectedSha256))
+}
+
+// ExpectedSha256NotNil applies the NotNil predicate on the "expected_sha256" field.
+func ExpectedSha256NotNil() predicate.Upload {
+	return predicate.Upload(sql.FieldNotNull(FieldExpectedSha256))
+}
+
+// ExpectedSha256EqualFold applies the EqualFold predicate on the "expected_sha256" field.
+func ExpectedSha256EqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldExpectedSha256, v))
+}
+
+// ExpectedSha256ContainsFold applies the ContainsFold predicate on the "expected_sha256" field.
+func ExpectedSha256ContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldExpectedSha256, v))
+}
+
+// ExpectedMd5EQ applies the EQ predicate on the "expected_md5" field.
+func ExpectedMd5EQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldExpectedMd5, v))
+}
+
+// ExpectedMd5NEQ applies the NEQ predicate on the "expected_md5" field.
+func ExpectedMd5NEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldExpectedMd5, v))
+}
+
+// ExpectedMd5In applies the In predicate on the "expected_md5" field.
+func ExpectedMd5In(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldExpectedMd5, vs...))
+}
+
+// ExpectedMd5NotIn applies the NotIn predicate on the "expected_md5" field.
+func ExpectedMd5NotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldExpectedMd5, vs...))
+}
+
+// ExpectedMd5GT applies the GT predicate on the "expected_md5" field.
+func ExpectedMd5GT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldExpectedMd5, v))
+}
+
+// ExpectedMd5GTE applies the GTE predicate on the "expected_md5" field.
+func ExpectedMd5GTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldExpectedMd5, v))
+}
+
+// ExpectedMd5LT applies the LT predicate on the "expected_md5" field.
+func ExpectedMd5LT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldExpectedMd5, v))
+}
+
+// ExpectedMd5LTE applies the LTE predicate on the "expected_md5" field.
+func ExpectedMd5LTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldExpectedMd5, v))
+}
+
+// ExpectedMd5Contains applies the Contains predicate on the "expected_md5" field.
+func ExpectedMd5Contains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldExpectedMd5, v))
+}
+
+// ExpectedMd5HasPrefix applies the HasPrefix predicate on the "expected_md5" field.
+func ExpectedMd5HasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldExpectedMd5, v))
+}
+
+// ExpectedMd5HasSuffix applies the HasSuffix predicate on the "expected_md5" field.
+func ExpectedMd5HasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldExpectedMd5, v))
+}
+
+// ExpectedMd5IsNil applies the IsNil predicate on the "expected_md5" field.
+func ExpectedMd5IsNil() predicate.Upload {
+	return predicate.Upload(sql.FieldIsNull(FieldExpectedMd5))
+}
+
+// ExpectedMd5NotNil applies the NotNil predicate on the "expected_md5" field.
+func ExpectedMd5NotNil() predicate.Upload {
+	return predicate.Upload(sql.FieldNotNull(FieldExpectedMd5))
+}
+
+// ExpectedMd5EqualFold applies the EqualFold predicate on the "expected_md5" field.
+func ExpectedMd5EqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldExpectedMd5, v))
+}
+
+// ExpectedMd5ContainsFold applies the ContainsFold predicate on the "expected_md5" field.
+func ExpectedMd5ContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldExpectedMd5, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.Upload {
+	return predicate.Upload(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.Upload {
+	return predicate.Upload(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// VisibilityGT applies the GT predicate on the "visibility" field.
+func VisibilityGT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldVisibility, v))
+}
+
+// VisibilityGTE applies the GTE predicate on the "visibility" field.
+func VisibilityGTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldVisibility, v))
+}
+
+// VisibilityLT applies the LT predicate on the "visibility" field.
+func VisibilityLT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldVisibility, v))
+}
+
+// VisibilityLTE applies the LTE predicate on the "visibility" field.
+func VisibilityLTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldVisibility, v))
+}
+
+// VisibilityContains applies the Contains predicate on the "visibility" field.
+func VisibilityContains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldVisibility, v))
+}
+
+// VisibilityHasPrefix applies the HasPrefix predicate on the "visibility" field.
+func VisibilityHasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldVisibility, v))
+}
+
+// VisibilityHasSuffix applies the HasSuffix predicate on the "visibility" field.
+func VisibilityHasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldVisibility, v))
+}
+
+// VisibilityIsNil applies the IsNil predicate on the "visibility" field.
+func VisibilityIsNil() predicate.Upload {
+	return predicate.Upload(sql.FieldIsNull(FieldVisibility))
+}
+
+// VisibilityNotNil applies the NotNil predicate on the "visibility" field.
+func VisibilityNotNil() predicate.Upload {
+	return predicate.Upload(sql.FieldNotNull(FieldVisibility))
+}
+
+// VisibilityEqualFold applies the EqualFold predicate on the "visibility" field.
+func VisibilityEqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldVisibility, v))
+}
+
+// VisibilityContainsFold applies the ContainsFold predicate on the "visibility" field.
+func VisibilityContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldVisibility, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldToken, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Upload) predicate.Upload {
+	return predicate.Upload(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Upload) predicate.Upload {
+	return predicate.Upload(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Upload) predicate.Upload {
+	return predicate.Upload(sql.NotPredicates(p))
+}
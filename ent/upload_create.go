@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"file-sharing/ent/upload"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UploadCreate is the builder for creating a Upload entity.
+type UploadCreate struct {
+	config
+	mutation *UploadMutation
+	hooks    []Hook
+}
+
+// SetUploadLength sets the "upload_length" field.
+func (_c *UploadCreate) SetUploadLength(v int64) *UploadCreate {
+	_c.mutation.SetUploadLength(v)
+	return _c
+}
+
+// SetUploadOffset sets the "upload_offset" field.
+func (_c *UploadCreate) SetUploadOffset(v int64) *UploadCreate {
+	_c.mutation.SetUploadOffset(v)
+	return _c
+}
+
+// SetNillableUploadOffset sets the "upload_offset" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableUploadOffset(v *int64) *UploadCreate {
+	if v != nil {
+		_c.SetUploadOffset(*v)
+	}
+	return _c
+}
+
+// SetFileName sets the "file_name" field.
+func (_c *UploadCreate) SetFileName(v string) *UploadCreate {
+	_c.mutation.SetFileName(v)
+	return _c
+}
+
+// SetMime sets the "mime" field.
+func (_c *UploadCreate) SetMime(v string) *UploadCreate {
+	_c.mutation.SetMime(v)
+	return _c
+}
+
+// SetPassword sets the "password" field.
+func (_c *UploadCreate) SetPassword(v string) *UploadCreate {
+	_c.mutation.SetPassword(v)
+	return _c
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (_c *UploadCreate) SetNillablePassword(v *string) *UploadCreate {
+	if v != nil {
+		_c.SetPassword(*v)
+	}
+	return _c
+}
+
+// SetManageToken sets the "manage_token" field.
+func (_c *UploadCreate) SetManageToken(v string) *UploadCreate {
+	_c.mutation.SetManageToken(v)
+	return _c
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_c *UploadCreate) SetMaxDownloads(v int) *UploadCreate {
+	_c.mutation.SetMaxDownloads(v)
+	return _c
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableMaxDownloads(v *int) *UploadCreate {
+	if v != nil {
+		_c.SetMaxDownloads(*v)
+	}
+	return _c
+}
+
+// SetFileExpiresAt sets the "file_expires_at" field.
+func (_c *UploadCreate) SetFileExpiresAt(v time.Time) *UploadCreate {
+	_c.mutation.SetFileExpiresAt(v)
+	return _c
+}
+
+// SetNillableFileExpiresAt sets the "file_expires_at" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableFileExpiresAt(v *time.Time) *UploadCreate {
+	if v != nil {
+		_c.SetFileExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetExpectedSha256 sets the "expected_sha256" field.
+func (_c *UploadCreate) SetExpectedSha256(v string) *UploadCreate {
+	_c.mutation.SetExpectedSha256(v)
+	return _c
+}
+
+// SetNillableExpectedSha256 sets the "expected_sha256" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableExpectedSha256(v *string) *UploadCreate {
+	if v != nil {
+		_c.SetExpectedSha256(*v)
+	}
+	return _c
+}
+
+// SetExpectedMd5 sets the "expected_md5" field.
+func (_c *UploadCreate) SetExpectedMd5(v string) *UploadCreate {
+	_c.mutation.SetExpectedMd5(v)
+	return _c
+}
+
+// SetNillableExpectedMd5 sets the "expected_md5" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableExpectedMd5(v *string) *UploadCreate {
+	if v != nil {
+		_c.SetExpectedMd5(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *UploadCreate) SetOwnerID(v string) *UploadCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableOwnerID(v *string) *UploadCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetVisibility sets the "visibility" field.
+func (_c *UploadCreate) SetVisibility(v string) *UploadCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableVisibility(v *string) *UploadCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *UploadCreate) SetToken(v string) *UploadCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UploadCreate) SetCreatedAt(v time.Time) *UploadCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableCreatedAt(v *time.Time) *UploadCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UploadCreate) SetUpdatedAt(v time.Time) *UploadCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableUpdatedAt(v *time.Time) *UploadCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UploadCreate) SetID(v string) *UploadCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableID(v *string) *UploadCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UploadMutation object of the builder.
+func (_c *UploadCreate) Mutation() *UploadMutation {
+	return _c.mutation
+}
+
+// Save creates the Upload in the database.
+func (_c *UploadCreate) Save(ctx context.Context) (*Upload, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UploadCreate) SaveX(ctx context.Context) *Upload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UploadCreate) defaults() {
+	if _, ok := _c.mutation.UploadOffset(); !ok {
+		v := upload.DefaultUploadOffset
+		_c.mutation.SetUploadOffset(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := upload.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := upload.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := upload.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UploadCreate) check() error {
+	if _, ok := _c.mutation.UploadLength(); !ok {
+		return &ValidationError{Name: "upload_length", err: errors.New(`ent: missing required field "Upload.upload_length"`)}
+	}
+	if _, ok := _c.mutation.UploadOffset(); !ok {
+		return &ValidationError{Name: "upload_offset", err: errors.New(`ent: missing required field "Upload.upload_offset"`)}
+	}
+	if _, ok := _c.mutation.FileName(); !ok {
+		return &ValidationError{Name: "file_name", err: errors.New(`ent: missing required field "Upload.file_name"`)}
+	}
+	if _, ok := _c.mutation.Mime(); !ok {
+		return &ValidationError{Name: "mime", err: errors.New(`ent: missing required field "Upload.mime"`)}
+	}
+	if _, ok := _c.mutation.ManageToken(); !ok {
+		return &ValidationError{Name: "manage_token", err: errors.New(`ent: missing required field "Upload.manage_token"`)}
+	}
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Upload.token"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Upload.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Upload.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *UploadCreate) sqlSave(ctx context.Context) (*Upload, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Upload.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UploadCreate) createSpec() (*Upload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Upload{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(upload.Table, sqlgraph.NewFieldSpec(upload.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UploadLength(); ok {
+		_spec.SetField(upload.FieldUploadLength, field.TypeInt64, value)
+		_node.UploadLength = value
+	}
+	if value, ok := _c.mutation.UploadOffset(); ok {
+		_spec.SetField(upload.FieldUploadOffset, field.TypeInt64, value)
+		_node.UploadOffset = value
+	}
+	if value, ok := _c.mutation.FileName(); ok {
+		_spec.SetField(upload.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := _c.mutation.Mime(); ok {
+		_spec.SetField(upload.FieldMime, field.TypeString, value)
+		_node.Mime = value
+	}
+	if value, ok := _c.mutation.Password(); ok {
+		_spec.SetField(upload.FieldPassword, field.TypeString, value)
+		_node.Password = &value
+	}
+	if value, ok := _c.mutation.ManageToken(); ok {
+		_spec.SetField(upload.FieldManageToken, field.TypeString, value)
+		_node.ManageToken = value
+	}
+	if value, ok := _c.mutation.MaxDownloads(); ok {
+		_spec.SetField(upload.FieldMaxDownloads, field.TypeInt, value)
+		_node.MaxDownloads = &value
+	}
+	if value, ok := _c.mutation.FileExpiresAt(); ok {
+		_spec.SetField(upload.FieldFileExpiresAt, field.TypeTime, value)
+		_node.FileExpiresAt = &value
+	}
+	if value, ok := _c.mutation.ExpectedSha256(); ok {
+		_spec.SetField(upload.FieldExpectedSha256, field.TypeString, value)
+		_node.ExpectedSha256 = &value
+	}
+	if value, ok := _c.mutation.ExpectedMd5(); ok {
+		_spec.SetField(upload.FieldExpectedMd5, field.TypeString, value)
+		_node.ExpectedMd5 = &value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(upload.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = &value
+	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(upload.FieldVisibility, field.TypeString, value)
+		_node.Visibility = &value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(upload.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(upload.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(upload.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// UploadCreateBulk is the builder for creating many Upload entities in bulk.
+type UploadCreateBulk struct {
+	config
+	err      error
+	builders []*UploadCreate
+}
+
+// Save creates the Upload entities in the database.
+func (_c *UploadCreateBulk) Save(ctx context.Context) ([]*Upload, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Upload, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UploadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UploadCreateBulk) SaveX(ctx context.Context) []*Upload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
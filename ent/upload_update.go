@@ -0,0 +1,840 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"file-sharing/ent/predicate"
+	"file-sharing/ent/upload"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UploadUpdate is the builder for updating Upload entities.
+type UploadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UploadMutation
+}
+
+// Where appends a list predicates to the UploadUpdate builder.
+func (_u *UploadUpdate) Where(ps ...predicate.Upload) *UploadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUploadLength sets the "upload_length" field.
+func (_u *UploadUpdate) SetUploadLength(v int64) *UploadUpdate {
+	_u.mutation.ResetUploadLength()
+	_u.mutation.SetUploadLength(v)
+	return _u
+}
+
+// SetNillableUploadLength sets the "upload_length" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableUploadLength(v *int64) *UploadUpdate {
+	if v != nil {
+		_u.SetUploadLength(*v)
+	}
+	return _u
+}
+
+// AddUploadLength adds value to the "upload_length" field.
+func (_u *UploadUpdate) AddUploadLength(v int64) *UploadUpdate {
+	_u.mutation.AddUploadLength(v)
+	return _u
+}
+
+// SetUploadOffset sets the "upload_offset" field.
+func (_u *UploadUpdate) SetUploadOffset(v int64) *UploadUpdate {
+	_u.mutation.ResetUploadOffset()
+	_u.mutation.SetUploadOffset(v)
+	return _u
+}
+
+// SetNillableUploadOffset sets the "upload_offset" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableUploadOffset(v *int64) *UploadUpdate {
+	if v != nil {
+		_u.SetUploadOffset(*v)
+	}
+	return _u
+}
+
+// AddUploadOffset adds value to the "upload_offset" field.
+func (_u *UploadUpdate) AddUploadOffset(v int64) *UploadUpdate {
+	_u.mutation.AddUploadOffset(v)
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *UploadUpdate) SetFileName(v string) *UploadUpdate {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableFileName(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// SetMime sets the "mime" field.
+func (_u *UploadUpdate) SetMime(v string) *UploadUpdate {
+	_u.mutation.SetMime(v)
+	return _u
+}
+
+// SetNillableMime sets the "mime" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableMime(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetMime(*v)
+	}
+	return _u
+}
+
+// SetPassword sets the "password" field.
+func (_u *UploadUpdate) SetPassword(v string) *UploadUpdate {
+	_u.mutation.SetPassword(v)
+	return _u
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillablePassword(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetPassword(*v)
+	}
+	return _u
+}
+
+// ClearPassword clears the value of the "password" field.
+func (_u *UploadUpdate) ClearPassword() *UploadUpdate {
+	_u.mutation.ClearPassword()
+	return _u
+}
+
+// SetManageToken sets the "manage_token" field.
+func (_u *UploadUpdate) SetManageToken(v string) *UploadUpdate {
+	_u.mutation.SetManageToken(v)
+	return _u
+}
+
+// SetNillableManageToken sets the "manage_token" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableManageToken(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetManageToken(*v)
+	}
+	return _u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_u *UploadUpdate) SetMaxDownloads(v int) *UploadUpdate {
+	_u.mutation.ResetMaxDownloads()
+	_u.mutation.SetMaxDownloads(v)
+	return _u
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableMaxDownloads(v *int) *UploadUpdate {
+	if v != nil {
+		_u.SetMaxDownloads(*v)
+	}
+	return _u
+}
+
+// AddMaxDownloads adds value to the "max_downloads" field.
+func (_u *UploadUpdate) AddMaxDownloads(v int) *UploadUpdate {
+	_u.mutation.AddMaxDownloads(v)
+	return _u
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (_u *UploadUpdate) ClearMaxDownloads() *UploadUpdate {
+	_u.mutation.ClearMaxDownloads()
+	return _u
+}
+
+// SetFileExpiresAt sets the "file_expires_at" field.
+func (_u *UploadUpdate) SetFileExpiresAt(v time.Time) *UploadUpdate {
+	_u.mutation.SetFileExpiresAt(v)
+	return _u
+}
+
+// SetNillableFileExpiresAt sets the "file_expires_at" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableFileExpiresAt(v *time.Time) *UploadUpdate {
+	if v != nil {
+		_u.SetFileExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearFileExpiresAt clears the value of the "file_expires_at" field.
+func (_u *UploadUpdate) ClearFileExpiresAt() *UploadUpdate {
+	_u.mutation.ClearFileExpiresAt()
+	return _u
+}
+
+// SetExpectedSha256 sets the "expected_sha256" field.
+func (_u *UploadUpdate) SetExpectedSha256(v string) *UploadUpdate {
+	_u.mutation.SetExpectedSha256(v)
+	return _u
+}
+
+// SetNillableExpectedSha256 sets the "expected_sha256" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableExpectedSha256(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetExpectedSha256(*v)
+	}
+	return _u
+}
+
+// ClearExpectedSha256 clears the value of the "expected_sha256" field.
+func (_u *UploadUpdate) ClearExpectedSha256() *UploadUpdate {
+	_u.mutation.ClearExpectedSha256()
+	return _u
+}
+
+// SetExpectedMd5 sets the "expected_md5" field.
+func (_u *UploadUpdate) SetExpectedMd5(v string) *UploadUpdate {
+	_u.mutation.SetExpectedMd5(v)
+	return _u
+}
+
+// SetNillableExpectedMd5 sets the "expected_md5" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableExpectedMd5(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetExpectedMd5(*v)
+	}
+	return _u
+}
+
+// ClearExpectedMd5 clears the value of the "expected_md5" field.
+func (_u *UploadUpdate) ClearExpectedMd5() *UploadUpdate {
+	_u.mutation.ClearExpectedMd5()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *UploadUpdate) SetOwnerID(v string) *UploadUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableOwnerID(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *UploadUpdate) ClearOwnerID() *UploadUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *UploadUpdate) SetVisibility(v string) *UploadUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableVisibility(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// ClearVisibility clears the value of the "visibility" field.
+func (_u *UploadUpdate) ClearVisibility() *UploadUpdate {
+	_u.mutation.ClearVisibility()
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *UploadUpdate) SetToken(v string) *UploadUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableToken(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UploadUpdate) SetUpdatedAt(v time.Time) *UploadUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the UploadMutation object of the builder.
+func (_u *UploadUpdate) Mutation() *UploadMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UploadUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UploadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UploadUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := upload.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *UploadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(upload.Table, upload.Columns, sqlgraph.NewFieldSpec(upload.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UploadLength(); ok {
+		_spec.SetField(upload.FieldUploadLength, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUploadLength(); ok {
+		_spec.AddField(upload.FieldUploadLength, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UploadOffset(); ok {
+		_spec.SetField(upload.FieldUploadOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUploadOffset(); ok {
+		_spec.AddField(upload.FieldUploadOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(upload.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Mime(); ok {
+		_spec.SetField(upload.FieldMime, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Password(); ok {
+		_spec.SetField(upload.FieldPassword, field.TypeString, value)
+	}
+	if _u.mutation.PasswordCleared() {
+		_spec.ClearField(upload.FieldPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.ManageToken(); ok {
+		_spec.SetField(upload.FieldManageToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxDownloads(); ok {
+		_spec.SetField(upload.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(upload.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if _u.mutation.MaxDownloadsCleared() {
+		_spec.ClearField(upload.FieldMaxDownloads, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FileExpiresAt(); ok {
+		_spec.SetField(upload.FieldFileExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.FileExpiresAtCleared() {
+		_spec.ClearField(upload.FieldFileExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpectedSha256(); ok {
+		_spec.SetField(upload.FieldExpectedSha256, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedSha256Cleared() {
+		_spec.ClearField(upload.FieldExpectedSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpectedMd5(); ok {
+		_spec.SetField(upload.FieldExpectedMd5, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedMd5Cleared() {
+		_spec.ClearField(upload.FieldExpectedMd5, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(upload.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(upload.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(upload.FieldVisibility, field.TypeString, value)
+	}
+	if _u.mutation.VisibilityCleared() {
+		_spec.ClearField(upload.FieldVisibility, field.TypeString)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(upload.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(upload.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{upload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UploadUpdateOne is the builder for updating a single Upload entity.
+type UploadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UploadMutation
+}
+
+// SetUploadLength sets the "upload_length" field.
+func (_u *UploadUpdateOne) SetUploadLength(v int64) *UploadUpdateOne {
+	_u.mutation.ResetUploadLength()
+	_u.mutation.SetUploadLength(v)
+	return _u
+}
+
+// SetNillableUploadLength sets the "upload_length" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableUploadLength(v *int64) *UploadUpdateOne {
+	if v != nil {
+		_u.SetUploadLength(*v)
+	}
+	return _u
+}
+
+// AddUploadLength adds value to the "upload_length" field.
+func (_u *UploadUpdateOne) AddUploadLength(v int64) *UploadUpdateOne {
+	_u.mutation.AddUploadLength(v)
+	return _u
+}
+
+// SetUploadOffset sets the "upload_offset" field.
+func (_u *UploadUpdateOne) SetUploadOffset(v int64) *UploadUpdateOne {
+	_u.mutation.ResetUploadOffset()
+	_u.mutation.SetUploadOffset(v)
+	return _u
+}
+
+// SetNillableUploadOffset sets the "upload_offset" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableUploadOffset(v *int64) *UploadUpdateOne {
+	if v != nil {
+		_u.SetUploadOffset(*v)
+	}
+	return _u
+}
+
+// AddUploadOffset adds value to the "upload_offset" field.
+func (_u *UploadUpdateOne) AddUploadOffset(v int64) *UploadUpdateOne {
+	_u.mutation.AddUploadOffset(v)
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *UploadUpdateOne) SetFileName(v string) *UploadUpdateOne {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableFileName(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// SetMime sets the "mime" field.
+func (_u *UploadUpdateOne) SetMime(v string) *UploadUpdateOne {
+	_u.mutation.SetMime(v)
+	return _u
+}
+
+// SetNillableMime sets the "mime" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableMime(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetMime(*v)
+	}
+	return _u
+}
+
+// SetPassword sets the "password" field.
+func (_u *UploadUpdateOne) SetPassword(v string) *UploadUpdateOne {
+	_u.mutation.SetPassword(v)
+	return _u
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillablePassword(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetPassword(*v)
+	}
+	return _u
+}
+
+// ClearPassword clears the value of the "password" field.
+func (_u *UploadUpdateOne) ClearPassword() *UploadUpdateOne {
+	_u.mutation.ClearPassword()
+	return _u
+}
+
+// SetManageToken sets the "manage_token" field.
+func (_u *UploadUpdateOne) SetManageToken(v string) *UploadUpdateOne {
+	_u.mutation.SetManageToken(v)
+	return _u
+}
+
+// SetNillableManageToken sets the "manage_token" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableManageToken(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetManageToken(*v)
+	}
+	return _u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_u *UploadUpdateOne) SetMaxDownloads(v int) *UploadUpdateOne {
+	_u.mutation.ResetMaxDownloads()
+	_u.mutation.SetMaxDownloads(v)
+	return _u
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableMaxDownloads(v *int) *UploadUpdateOne {
+	if v != nil {
+		_u.SetMaxDownloads(*v)
+	}
+	return _u
+}
+
+// AddMaxDownloads adds value to the "max_downloads" field.
+func (_u *UploadUpdateOne) AddMaxDownloads(v int) *UploadUpdateOne {
+	_u.mutation.AddMaxDownloads(v)
+	return _u
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (_u *UploadUpdateOne) ClearMaxDownloads() *UploadUpdateOne {
+	_u.mutation.ClearMaxDownloads()
+	return _u
+}
+
+// SetFileExpiresAt sets the "file_expires_at" field.
+func (_u *UploadUpdateOne) SetFileExpiresAt(v time.Time) *UploadUpdateOne {
+	_u.mutation.SetFileExpiresAt(v)
+	return _u
+}
+
+// SetNillableFileExpiresAt sets the "file_expires_at" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableFileExpiresAt(v *time.Time) *UploadUpdateOne {
+	if v != nil {
+		_u.SetFileExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearFileExpiresAt clears the value of the "file_expires_at" field.
+func (_u *UploadUpdateOne) ClearFileExpiresAt() *UploadUpdateOne {
+	_u.mutation.ClearFileExpiresAt()
+	return _u
+}
+
+// SetExpectedSha256 sets the "expected_sha256" field.
+func (_u *UploadUpdateOne) SetExpectedSha256(v string) *UploadUpdateOne {
+	_u.mutation.SetExpectedSha256(v)
+	return _u
+}
+
+// SetNillableExpectedSha256 sets the "expected_sha256" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableExpectedSha256(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetExpectedSha256(*v)
+	}
+	return _u
+}
+
+// ClearExpectedSha256 clears the value of the "expected_sha256" field.
+func (_u *UploadUpdateOne) ClearExpectedSha256() *UploadUpdateOne {
+	_u.mutation.ClearExpectedSha256()
+	return _u
+}
+
+// SetExpectedMd5 sets the "expected_md5" field.
+func (_u *UploadUpdateOne) SetExpectedMd5(v string) *UploadUpdateOne {
+	_u.mutation.SetExpectedMd5(v)
+	return _u
+}
+
+// SetNillableExpectedMd5 sets the "expected_md5" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableExpectedMd5(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetExpectedMd5(*v)
+	}
+	return _u
+}
+
+// ClearExpectedMd5 clears the value of the "expected_md5" field.
+func (_u *UploadUpdateOne) ClearExpectedMd5() *UploadUpdateOne {
+	_u.mutation.ClearExpectedMd5()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *UploadUpdateOne) SetOwnerID(v string) *UploadUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableOwnerID(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *UploadUpdateOne) ClearOwnerID() *UploadUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *UploadUpdateOne) SetVisibility(v string) *UploadUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableVisibility(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// ClearVisibility clears the value of the "visibility" field.
+func (_u *UploadUpdateOne) ClearVisibility() *UploadUpdateOne {
+	_u.mutation.ClearVisibility()
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *UploadUpdateOne) SetToken(v string) *UploadUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableToken(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UploadUpdateOne) SetUpdatedAt(v time.Time) *UploadUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the UploadMutation object of the builder.
+func (_u *UploadUpdateOne) Mutation() *UploadMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UploadUpdate builder.
+func (_u *UploadUpdateOne) Where(ps ...predicate.Upload) *UploadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UploadUpdateOne) Select(field string, fields ...string) *UploadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Upload entity.
+func (_u *UploadUpdateOne) Save(ctx context.Context) (*Upload, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadUpdateOne) SaveX(ctx context.Context) *Upload {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UploadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UploadUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := upload.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *UploadUpdateOne) sqlSave(ctx context.Context) (_node *Upload, err error) {
+	_spec := sqlgraph.NewUpdateSpec(upload.Table, upload.Columns, sqlgraph.NewFieldSpec(upload.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Upload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, upload.FieldID)
+		for _, f := range fields {
+			if !upload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != upload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UploadLength(); ok {
+		_spec.SetField(upload.FieldUploadLength, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUploadLength(); ok {
+		_spec.AddField(upload.FieldUploadLength, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UploadOffset(); ok {
+		_spec.SetField(upload.FieldUploadOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUploadOffset(); ok {
+		_spec.AddField(upload.FieldUploadOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(upload.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Mime(); ok {
+		_spec.SetField(upload.FieldMime, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Password(); ok {
+		_spec.SetField(upload.FieldPassword, field.TypeString, value)
+	}
+	if _u.mutation.PasswordCleared() {
+		_spec.ClearField(upload.FieldPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.ManageToken(); ok {
+		_spec.SetField(upload.FieldManageToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxDownloads(); ok {
+		_spec.SetField(upload.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(upload.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if _u.mutation.MaxDownloadsCleared() {
+		_spec.ClearField(upload.FieldMaxDownloads, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FileExpiresAt(); ok {
+		_spec.SetField(upload.FieldFileExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.FileExpiresAtCleared() {
+		_spec.ClearField(upload.FieldFileExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpectedSha256(); ok {
+		_spec.SetField(upload.FieldExpectedSha256, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedSha256Cleared() {
+		_spec.ClearField(upload.FieldExpectedSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpectedMd5(); ok {
+		_spec.SetField(upload.FieldExpectedMd5, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedMd5Cleared() {
+		_spec.ClearField(upload.FieldExpectedMd5, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(upload.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(upload.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(upload.FieldVisibility, field.TypeString, value)
+	}
+	if _u.mutation.VisibilityCleared() {
+		_spec.ClearField(upload.FieldVisibility, field.TypeString)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(upload.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(upload.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Upload{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{upload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,91 @@
+package cron
+
+import (
+	"context"
+	"file-sharing/config"
+	"file-sharing/internal/services"
+	"log"
+	"time"
+)
+
+// Expiry periodically deletes expired files, writing auto delete logs, expired bundles, links and abandoned upload sessions.
+type Expiry struct {
+	cfg      *config.Config
+	fs       *services.File
+	us       *services.Upload
+	bs       *services.Bundle
+	ls       *services.Link
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func NewExpiry(cfg *config.Config, fs *services.File, us *services.Upload, bs *services.Bundle, ls *services.Link) *Expiry {
+	return &Expiry{
+		cfg:      cfg,
+		fs:       fs,
+		us:       us,
+		bs:       bs,
+		ls:       ls,
+		interval: time.Duration(cfg.ExpiryInterval) * time.Minute,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the reaper once immediately, then on every interval in background.
+func (e *Expiry) Start() {
+	go func() {
+		defer close(e.done)
+
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+
+		for {
+			e.run()
+			select {
+			case <-e.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop signals the reaper to stop and waits for the running batch to finish.
+func (e *Expiry) Stop() {
+	close(e.stop)
+	<-e.done
+}
+
+func (e *Expiry) run() {
+	ctx := context.Background()
+
+	files, err := e.fs.DeleteExpired(ctx, e.cfg.AutoDeleteLogPath())
+	if err != nil {
+		log.Printf("Error deleting expired files:\n%v", err)
+	} else if len(files) > 0 {
+		log.Printf("Deleted %v expired files", len(files))
+	}
+
+	bundles, err := e.bs.DeleteExpired(ctx)
+	if err != nil {
+		log.Printf("Error deleting expired bundles:\n%v", err)
+	} else if bundles > 0 {
+		log.Printf("Deleted %v expired bundles", bundles)
+	}
+
+	links, err := e.ls.DeleteExpired(ctx)
+	if err != nil {
+		log.Printf("Error deleting expired links:\n%v", err)
+	} else if links > 0 {
+		log.Printf("Deleted %v expired links", links)
+	}
+
+	uploads, err := e.us.DeleteAbandoned(ctx)
+	if err != nil {
+		log.Printf("Error deleting abandoned uploads:\n%v", err)
+	} else if len(uploads) > 0 {
+		log.Printf("Deleted %v abandoned uploads", len(uploads))
+	}
+}
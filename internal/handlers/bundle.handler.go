@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"file-sharing/config"
+	"file-sharing/ent"
+	"file-sharing/internal/lib/archivelib"
+	"file-sharing/internal/lib/authlib"
+	"file-sharing/internal/lib/filelib"
+	"file-sharing/internal/lib/reply"
+	"file-sharing/internal/services"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// authorizeManage replies forbidden and returns false if request is neither from bundle's owner or an admin nor carries its management token.
+func (h *Bundle) authorizeManage(c *gin.Context, b *ent.Bundle) bool {
+	u := authlib.GetUser(c)
+	if filelib.IsBundleOwner(b, u) || u != nil && h.cfg.IsAdmin(u.Username) {
+		return true
+	}
+	if !filelib.IsBundleManageTokenCorrect(b, c.GetHeader("X-Manage-Token")) {
+		reply.New(c).Error(reply.CodeForbidden, "Invalid management token", "Send management token from upload in 'X-Manage-Token' header, or API key of the owner").Fail()
+		return false
+	}
+	return true
+}
+
+type Bundle struct {
+	s   *services.Bundle
+	cfg *config.Config
+}
+
+func NewBundle(service *services.Bundle, cfg *config.Config) *Bundle {
+	return &Bundle{service, cfg}
+}
+
+func (h *Bundle) GetOne(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+	token := c.Param("token")
+
+	b, err := s.GetOne(token, true)
+	if err != nil {
+		return
+	}
+
+	files, err := s.GetFiles(b, true)
+	if err != nil {
+		return
+	}
+
+	rp.Success(services.BundleFiles{Bundle: b, Files: files}).Ok()
+}
+
+func (h *Bundle) Download(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+	token := c.Param("token")
+	pw := authlib.GetPassword(c)
+	signed := c.Query("signature") != ""
+	format := c.DefaultQuery("format", archivelib.FormatZip)
+
+	if !archivelib.IsSupported(format) {
+		rp.Error(reply.CodeBadRequest, "Please use format 'zip' or 'tar.gz'").Fail()
+		return
+	}
+
+	b, err := s.GetOne(token, true)
+	if err != nil {
+		return
+	}
+
+	if !filelib.HasBundleDownloadSlot(b) {
+		rp.Error(reply.CodeBadRequest, "Max download reached").Fail()
+		return
+	}
+
+	// Signed URL stands in for the password
+	if signed {
+		if err := s.VerifyDownloadURL(b, true); err != nil {
+			return
+		}
+	} else if err := s.CheckPassword(b, pw, true); err != nil {
+		return
+	}
+
+	files, err := s.GetFiles(b, true)
+	if err != nil {
+		return
+	}
+
+	// Members edited to another password or out of downloads are left out, their password is never checked here
+	// so a bundle can't be used to guess it past the lockout of the file
+	downloadable := []*ent.File{}
+	for _, f := range files {
+		if !filelib.HasDownloadSlot(f) || !filelib.IsSharingBundlePassword(f, b) {
+			continue
+		}
+		// Members encrypted by password are left out of signed URLs, see CreateDownloadURL
+		if signed && filelib.IsPasswordEncrypted(f) {
+			continue
+		}
+		downloadable = append(downloadable, f)
+	}
+	if len(downloadable) == 0 {
+		rp.Error(reply.CodeBadRequest, "No file in bundle can be downloaded").Fail()
+		return
+	}
+
+	s.SendToDownload(b, downloadable, format, pw)
+}
+
+func (h *Bundle) DeleteOne(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+	token := c.Param("token")
+
+	b, err := s.GetOne(token, true)
+	if err != nil {
+		return
+	}
+	if !h.authorizeManage(c, b) {
+		return
+	}
+
+	if err := s.DeleteOne(b, h.cfg.RequestDeleteLogPath(), true); err != nil {
+		return
+	}
+
+	rp.Success(b).SetInfo("Bundle successfully deleted").Ok()
+}
+
+// CreateDownloadURL exchanges password for a short-lived signed URL, so browsers can download with a plain GET.
+func (h *Bundle) CreateDownloadURL(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+	token := c.Param("token")
+
+	b, err := s.GetOne(token, true)
+	if err != nil {
+		return
+	}
+	if err := s.CheckPassword(b, authlib.GetPassword(c), true); err != nil {
+		return
+	}
+
+	files, err := s.GetFiles(b, true)
+	if err != nil {
+		return
+	}
+	if slices.ContainsFunc(files, filelib.IsPasswordEncrypted) {
+		rp.Error(reply.CodeBadRequest, "Bundle is encrypted by its password, please download it with the password instead").Fail()
+		return
+	}
+
+	rp.Success(s.CreateDownloadURL(b)).Created()
+}
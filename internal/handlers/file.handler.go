@@ -3,46 +3,82 @@ package handlers
 import (
 	"file-sharing/config"
 	"file-sharing/ent"
+	"file-sharing/internal/lib/authlib"
 	"file-sharing/internal/lib/filelib"
 	"file-sharing/internal/lib/reply"
 	"file-sharing/internal/services"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
 
+// authorizeManage replies and returns false if request is neither from file's owner or an admin nor carries its management token.
+// Files uploaded before management tokens are still managed by their password in 'X-Password' header, as they used to be deleted,
+// those without a password only by admins.
+func (h *File) authorizeManage(c *gin.Context, s *services.AttachedGinFile, file *ent.File) bool {
+	u := authlib.GetUser(c)
+	if filelib.IsOwner(file, u) || u != nil && h.cfg.IsAdmin(u.Username) {
+		return true
+	}
+	if file.ManageToken == nil && file.Password != nil {
+		// Header only, as 'password' form field of an edit is the new password
+		return s.CheckPassword(file, c.GetHeader("X-Password"), true) == nil
+	}
+	if !filelib.IsManageTokenCorrect(file, c.GetHeader("X-Manage-Token")) {
+		reply.New(c).Error(reply.CodeForbidden, "Invalid management token", "Send management token from upload in 'X-Manage-Token' header, or API key of the owner").Fail()
+		return false
+	}
+	return true
+}
+
 type File struct {
-	s *services.File
+	s   *services.File
+	bs  *services.Bundle
+	ls  *services.Link
+	cfg *config.Config
 }
 
-func NewFile(service *services.File) *File {
-	return &File{service}
+func NewFile(service *services.File, bundleService *services.Bundle, linkService *services.Link, cfg *config.Config) *File {
+	return &File{service, bundleService, linkService, cfg}
 }
 
 func (h *File) CreateOne(c *gin.Context) {
 	s := h.s.AttachGin(c)
 	rp := reply.New(c)
 
-	file, err := s.ProcessUpload(true)
+	files, err := s.ReadUploadForm(true)
+	if err != nil {
+		return
+	}
+
+	// Many files are shared together as a bundle
+	if len(files) > 1 {
+		b, err := h.bs.AttachGin(c).ProcessUpload(files, true)
+		if err != nil {
+			return
+		}
+
+		rp.Success(b).SetInfo("Bundle successfully uploaded, keep manage_token secret as it is only shown once").Created()
+		return
+	}
+
+	file, err := s.ProcessUpload(files[0], true)
 
 	if err != nil {
 		return
 	}
 
-	rp.Success(file).SetInfo("File successfully uploaded").Created()
+	rp.Success(file).SetInfo("File successfully uploaded, keep manage_token secret as it is only shown once").Created()
 }
 
 func (h *File) GetMany(c *gin.Context) {
 	rp := reply.New(c)
 	s := h.s.AttachGin(c)
-	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 
-	f, err := s.GetMany(offset)
+	f, page, err := s.GetMany(true)
 	if err != nil {
-		rp.Error(reply.CodeBadGateWay, err.Error()).Fail()
 		return
 	}
-	rp.Success(f).Ok()
+	rp.Success(f).SetPagination(page).Ok()
 }
 
 func (h *File) GetOne(c *gin.Context) {
@@ -59,53 +95,136 @@ func (h *File) GetOne(c *gin.Context) {
 }
 
 func (h *File) Download(c *gin.Context) {
+	s := h.s.AttachGin(c)
+	token := c.Param("token")
+
+	file, err := s.GetOne(token, true)
+	if err != nil {
+		return
+	}
+
+	// Signed URL stands in for the password
+	if c.Query("signature") != "" {
+		err = s.VerifyDownloadURL(file, true)
+	} else {
+		err = s.CheckPassword(file, authlib.GetPassword(c), true)
+	}
+	if err != nil {
+		return
+	}
+
+	s.SendToDownload(file, authlib.GetPassword(c), "", nil)
+}
+
+// CreateDownloadURL exchanges password for a short-lived signed URL, so browsers can download with a plain GET.
+func (h *File) CreateDownloadURL(c *gin.Context) {
 	rp := reply.New(c)
 	s := h.s.AttachGin(c)
 	token := c.Param("token")
-	pw := c.Query("password")
 
 	file, err := s.GetOne(token, true)
 	if err != nil {
 		return
 	}
+	if err := s.CheckPassword(file, authlib.GetPassword(c), true); err != nil {
+		return
+	}
+	if filelib.IsPasswordEncrypted(file) {
+		rp.Error(reply.CodeBadRequest, "File is encrypted by its password, please download it with the password instead").Fail()
+		return
+	}
 
-	if a, c := filelib.IsDownloadable(file, pw); !a {
-		message := "Max download reached"
-		if c == "PASSWORD" {
-			message = "Wrong password"
-		}
-		rp.Error(reply.CodeBadRequest, message).Fail()
+	rp.Success(s.CreateDownloadURL(file)).Created()
+}
+
+// CreateLink mints a presigned link for owner or holder of management token, it carries neither share token nor password.
+func (h *File) CreateLink(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+	ls := h.ls.AttachGin(c)
+	token := c.Param("token")
+
+	file, err := s.GetOne(token, true)
+	if err != nil {
+		return
+	}
+	if !h.authorizeManage(c, s, file) {
+		return
+	}
+
+	var opt services.LinkOptions
+	if err := c.ShouldBind(&opt); err != nil {
+		rp.Error(reply.CodeBadRequest, "Invalid link fields", err.Error()).Fail()
+		return
+	}
+
+	link, err := ls.Create(file, &opt, true)
+	if err != nil {
 		return
 	}
 
-	s.SendToDownload(file)
+	rp.Success(link).Created()
 }
 
-func (h *File) DeleteOne(c *gin.Context) {
+// DownloadLink downloads file of a presigned link, signature stands in for share token and password.
+func (h *File) DownloadLink(c *gin.Context) {
+	s := h.s.AttachGin(c)
+	ls := h.ls.AttachGin(c)
+
+	file, err := ls.GetFile(c.Param("id"), true)
+	if err != nil {
+		return
+	}
+
+	// Claims of a link only resume downloads of the same link
+	s.SendToDownload(file, "", "link:"+c.Query("nonce"), ls.ClaimDownload(file))
+}
+
+func (h *File) UpdateOne(c *gin.Context) {
 	rp := reply.New(c)
 	s := h.s.AttachGin(c)
 	token := c.Param("token")
-	pw := c.Query("password")
 
 	file, err := s.GetOne(token, true)
 	if err != nil {
 		return
 	}
-	if !filelib.IsPasswordCorrect(file, pw) {
-		rp.Error(reply.CodeBadRequest, "Wrong password").Fail()
+	if !h.authorizeManage(c, s, file) {
 		return
 	}
 
-	err = filelib.CreateDeleteLog([]*ent.File{file}, config.REQUEST_DELETE_LOG_PATH)
+	var edit services.FileEdit
+	if err := c.ShouldBind(&edit); err != nil {
+		rp.Error(reply.CodeBadRequest, "Invalid edit fields", err.Error()).Fail()
+		return
+	}
+
+	file, err = s.UpdateOne(file, &edit, true)
 	if err != nil {
-		rp.Error(reply.CodeServerError, err.Error()).Fail()
 		return
 	}
 
-	err = s.DeleteOneFile(file, true)
+	rp.Success(file).SetInfo(file.FileName + " successfully updated").Ok()
+}
+
+func (h *File) DeleteOne(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+	token := c.Param("token")
+
+	file, err := s.GetOne(token, true)
 	if err != nil {
 		return
 	}
+	if !h.authorizeManage(c, s, file) {
+		return
+	}
+
+	err = s.CreateDeleteLog([]*ent.File{file}, h.cfg.RequestDeleteLogPath())
+	if err != nil {
+		rp.Error(reply.CodeServerError, err.Error()).Fail()
+		return
+	}
 
 	_, err = s.DeleteOne(token, true)
 	if err != nil {
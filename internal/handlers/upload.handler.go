@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"file-sharing/ent"
+	"file-sharing/internal/lib/reply"
+	"file-sharing/internal/lib/tuslib"
+	"file-sharing/internal/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Upload handles resumable uploads following tus protocol (https://tus.io/protocols/resumable-upload).
+type Upload struct {
+	s *services.Upload
+}
+
+func NewUpload(service *services.Upload) *Upload {
+	return &Upload{service}
+}
+
+func (h *Upload) setHeaders(c *gin.Context, u *ent.Upload) {
+	c.Header("Upload-Offset", strconv.FormatInt(u.UploadOffset, 10))
+	c.Header("Upload-Length", strconv.FormatInt(u.UploadLength, 10))
+	c.Header("Upload-Expires", h.s.GetExpiry(u).UTC().Format(http.TimeFormat))
+	c.Header("Cache-Control", "no-store")
+}
+
+func (h *Upload) Options(c *gin.Context) {
+	c.Header("Tus-Version", tuslib.Version)
+	c.Header("Tus-Extension", tuslib.Extensions)
+	c.Header("Tus-Max-Size", strconv.FormatInt(h.s.GetMaxSize(), 10))
+	c.Status(http.StatusNoContent)
+}
+
+func (h *Upload) CreateOne(c *gin.Context) {
+	s := h.s.AttachGin(c)
+	rp := reply.New(c)
+
+	u, err := s.Create(true)
+	if err != nil {
+		return
+	}
+
+	h.setHeaders(c, u.Upload)
+	c.Header("Location", "/uploads/"+u.ID)
+	rp.Success(u).SetInfo("Upload created, keep manage_token secret as it is only shown once").Created()
+}
+
+func (h *Upload) GetOffset(c *gin.Context) {
+	s := h.s.AttachGin(c)
+
+	u, err := s.GetOne(c.Param("id"), false)
+	if err != nil {
+		// HEAD response can not have body
+		c.Status(http.StatusNotFound)
+		return
+	}
+
+	h.setHeaders(c, u)
+	c.Status(http.StatusOK)
+}
+
+func (h *Upload) Append(c *gin.Context) {
+	s := h.s.AttachGin(c)
+
+	u, err := s.Append(c.Param("id"), true)
+	if err != nil {
+		return
+	}
+
+	// Turn the session into a file once every byte is received
+	if u.UploadOffset == u.UploadLength {
+		file, err := s.Finalize(u, true)
+		if err != nil {
+			return
+		}
+		c.Header("File-Token", file.Token)
+	}
+
+	c.Header("Upload-Offset", strconv.FormatInt(u.UploadOffset, 10))
+	c.Header("Upload-Expires", h.s.GetExpiry(u).UTC().Format(http.TimeFormat))
+	c.Status(http.StatusNoContent)
+}
+
+func (h *Upload) DeleteOne(c *gin.Context) {
+	s := h.s.AttachGin(c)
+
+	u, err := s.GetOne(c.Param("id"), true)
+	if err != nil {
+		return
+	}
+
+	if err := s.DeleteOne(u, true); err != nil {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"file-sharing/internal/lib/authlib"
+	"file-sharing/internal/lib/reply"
+	"file-sharing/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type User struct {
+	s *services.User
+}
+
+func NewUser(service *services.User) *User {
+	return &User{service}
+}
+
+func (h *User) Register(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+
+	var cred services.Credentials
+	if err := c.ShouldBind(&cred); err != nil {
+		rp.Error(reply.CodeBadRequest, "Invalid register fields", err.Error()).Fail()
+		return
+	}
+
+	u, err := s.Register(&cred, true)
+	if err != nil {
+		return
+	}
+
+	rp.Success(u).SetInfo("User successfully registered, login to get an API key").Created()
+}
+
+func (h *User) Login(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+
+	var cred services.Credentials
+	if err := c.ShouldBind(&cred); err != nil {
+		rp.Error(reply.CodeBadRequest, "Invalid login fields", err.Error()).Fail()
+		return
+	}
+
+	k, err := s.Login(&cred, true)
+	if err != nil {
+		return
+	}
+
+	rp.Success(k).SetInfo("Send key in 'Authorization: Bearer <key>' header, keep it secret as it is only shown once").Created()
+}
+
+func (h *User) GetMe(c *gin.Context) {
+	reply.New(c).Success(authlib.GetUser(c)).Ok()
+}
+
+func (h *User) GetKeys(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+
+	keys, err := s.GetKeys(authlib.GetUser(c))
+	if err != nil {
+		rp.Error(reply.CodeBadGateWay, err.Error()).Fail()
+		return
+	}
+	rp.Success(keys).Ok()
+}
+
+func (h *User) CreateKey(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+
+	var body struct {
+		Name string `form:"name" json:"name"`
+	}
+	if err := c.ShouldBind(&body); err != nil {
+		rp.Error(reply.CodeBadRequest, "Invalid key fields", err.Error()).Fail()
+		return
+	}
+
+	k, err := s.CreateKey(authlib.GetUser(c), body.Name, true)
+	if err != nil {
+		return
+	}
+
+	rp.Success(k).SetInfo("API key created, keep it secret as it is only shown once").Created()
+}
+
+func (h *User) DeleteKey(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+
+	if err := s.DeleteKey(authlib.GetUser(c), c.Param("id"), true); err != nil {
+		return
+	}
+
+	rp.Success(nil).SetInfo("API key successfully revoked").Ok()
+}
+
+func (h *User) GetUsage(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+
+	usage, err := s.GetUsage(authlib.GetUser(c), true)
+	if err != nil {
+		return
+	}
+	rp.Success(usage).Ok()
+}
+
+func (h *User) SetPlan(c *gin.Context) {
+	rp := reply.New(c)
+	s := h.s.AttachGin(c)
+
+	var body struct {
+		Plan string `form:"plan" json:"plan"`
+	}
+	if err := c.ShouldBind(&body); err != nil {
+		rp.Error(reply.CodeBadRequest, "Invalid plan fields", err.Error()).Fail()
+		return
+	}
+
+	u, err := s.SetPlan(c.Param("username"), body.Plan, true)
+	if err != nil {
+		return
+	}
+
+	rp.Success(u).SetInfo("Plan successfully assigned").Ok()
+}
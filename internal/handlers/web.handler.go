@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Web struct {
+	fs http.FileSystem
+}
+
+func NewWeb(fs http.FileSystem) *Web {
+	return &Web{fs}
+}
+
+// Share serves page decrypting a client-encrypted file, its key is in the URL fragment which browsers never send.
+func (h *Web) Share(c *gin.Context) {
+	c.FileFromFS("share.html", h.fs)
+}
@@ -0,0 +1,122 @@
+package archivelib
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	FormatZip   = "zip"
+	FormatTarGz = "tar.gz"
+)
+
+// Entry is a file written into an archive, its content is opened only when it is written.
+type Entry struct {
+	Name    string
+	Size    int64
+	ModTime time.Time
+	Open    func() (io.ReadCloser, error)
+}
+
+func IsSupported(format string) bool {
+	return format == FormatZip || format == FormatTarGz
+}
+
+func GetContentType(format string) string {
+	if format == FormatTarGz {
+		return "application/gzip"
+	}
+	return "application/zip"
+}
+
+// UniqueNames renames duplicated entry names like "name (1).ext", so no entry is overwritten on extract.
+func UniqueNames(entries []Entry) {
+	used := map[string]bool{}
+	for i := range entries {
+		name := entries[i].Name
+		ext := path.Ext(name)
+		base := strings.TrimSuffix(name, ext)
+		for n := 1; used[name]; n++ {
+			name = fmt.Sprintf("%v (%v)%v", base, n, ext)
+		}
+		used[name] = true
+		entries[i].Name = name
+	}
+}
+
+// Write streams entries as an archive of format into w, without buffering whole content.
+func Write(w io.Writer, format string, entries []Entry) error {
+	switch format {
+	case FormatZip:
+		return writeZip(w, entries)
+	case FormatTarGz:
+		return writeTarGz(w, entries)
+	}
+	return fmt.Errorf("archive: unsupported format %q", format)
+}
+
+func copyEntry(dst io.Writer, e Entry) error {
+	r, err := e.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	n, err := io.Copy(dst, r)
+	if err != nil {
+		return err
+	}
+	if n != e.Size {
+		return fmt.Errorf("archive: %v has %v bytes, expected %v", e.Name, n, e.Size)
+	}
+	return nil
+}
+
+func writeZip(w io.Writer, entries []Entry) error {
+	zw := zip.NewWriter(w)
+	for _, e := range entries {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     e.Name,
+			Method:   zip.Deflate,
+			Modified: e.ModTime,
+		})
+		if err != nil {
+			return err
+		}
+		if err := copyEntry(fw, e); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func writeTarGz(w io.Writer, entries []Entry) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, e := range entries {
+		err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     e.Name,
+			Size:     e.Size,
+			Mode:     0644,
+			ModTime:  e.ModTime,
+			Format:   tar.FormatPAX,
+		})
+		if err != nil {
+			return err
+		}
+		if err := copyEntry(tw, e); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
@@ -0,0 +1,60 @@
+package authlib
+
+import (
+	"file-sharing/ent"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const userKey = "user"
+
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,32}$`)
+
+// GetBearer returns token of "Authorization: Bearer <token>" header, empty if not sent.
+func GetBearer(c *gin.Context) string {
+	scheme, token, ok := strings.Cut(c.GetHeader("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// GetPassword returns share password sent in "X-Password" header or "password" field of request body.
+// Query string is never read, as it ends up in access logs and browser history.
+func GetPassword(c *gin.Context) string {
+	if pw := c.GetHeader("X-Password"); pw != "" {
+		return pw
+	}
+	return c.PostForm("password")
+}
+
+// SetUser stores authenticated user of request.
+func SetUser(c *gin.Context, user *ent.User) {
+	c.Set(userKey, user)
+}
+
+// GetUser returns authenticated user of request, nil for anonymous requests.
+func GetUser(c *gin.Context) *ent.User {
+	if u, ok := c.Get(userKey); ok {
+		return u.(*ent.User)
+	}
+	return nil
+}
+
+// GetUserID returns id of authenticated user as optional owner of created records.
+func GetUserID(c *gin.Context) *string {
+	if u := GetUser(c); u != nil {
+		return &u.ID
+	}
+	return nil
+}
+
+func IsValidUsername(username string) bool {
+	return usernamePattern.MatchString(username)
+}
+
+func IsValidPassword(password string) bool {
+	return len(password) >= 8 && len(password) <= 72 // bcrypt ignores bytes after 72
+}
@@ -0,0 +1,82 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2id cost of deriving a key from a password, stored along with every wrapped key so it can be raised later
+const (
+	kdfTime    = 3
+	kdfMemory  = 64 * 1024 // KiB
+	kdfThreads = 4
+	kdfSalt    = 16
+)
+
+var ErrWrongPassword = errors.New("crypto: data key can not be unwrapped with this password")
+
+func deriveKey(password string, salt []byte, time, memory uint32, threads uint8) []byte {
+	return argon2.IDKey([]byte(password), salt, time, memory, threads, KeySize)
+}
+
+// WrapWithPassword encrypts data key with a key derived from password,
+// returning "argon2id$t=..,m=..,p=..$salt$sealed" to be stored.
+func WrapWithPassword(dataKey []byte, password string) string {
+	salt := make([]byte, kdfSalt)
+	rand.Read(salt)
+
+	aead, _ := newAEAD(deriveKey(password, salt, kdfTime, kdfMemory, kdfThreads))
+	nonce := make([]byte, aead.NonceSize())
+	rand.Read(nonce)
+
+	params := fmt.Sprintf("t=%v,m=%v,p=%v", kdfTime, kdfMemory, kdfThreads)
+	sealed := aead.Seal(nonce, nonce, dataKey, []byte(params))
+	return strings.Join([]string{
+		"argon2id",
+		params,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(sealed),
+	}, "$")
+}
+
+// UnwrapWithPassword decrypts data key wrapped by WrapWithPassword.
+func UnwrapWithPassword(wrapped, password string) ([]byte, error) {
+	malformed := errors.New("crypto: malformed password wrapped data key")
+
+	parts := strings.Split(wrapped, "$")
+	if len(parts) != 4 || parts[0] != "argon2id" {
+		return nil, malformed
+	}
+	var time, memory uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[1], "t=%d,m=%d,p=%d", &time, &memory, &threads); err != nil {
+		return nil, malformed
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, malformed
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return nil, malformed
+	}
+
+	aead, err := newAEAD(deriveKey(password, salt, time, memory, threads))
+	if err != nil {
+		return nil, err
+	}
+	n := aead.NonceSize()
+	if len(sealed) < n {
+		return nil, malformed
+	}
+	dataKey, err := aead.Open(nil, sealed[:n], sealed[n:], []byte(parts[1]))
+	if err != nil {
+		return nil, ErrWrongPassword
+	}
+	return dataKey, nil
+}
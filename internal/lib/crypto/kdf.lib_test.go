@@ -0,0 +1,42 @@
+package crypto
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWrapWithPassword(t *testing.T) {
+	dataKey := NewDataKey()
+	wrapped := WrapWithPassword(dataKey, "secret")
+	if !strings.HasPrefix(wrapped, "argon2id$t=3,m=65536,p=4$") {
+		t.Fatalf("wrapped key %q lacks argon2id parameters", wrapped)
+	}
+
+	got, err := UnwrapWithPassword(wrapped, "secret")
+	if err != nil || !bytes.Equal(got, dataKey) {
+		t.Fatalf("unwrap: %v", err)
+	}
+	if _, err := UnwrapWithPassword(wrapped, "Secret"); !errors.Is(err, ErrWrongPassword) {
+		t.Fatalf("unwrap with wrong password: got %v, want ErrWrongPassword", err)
+	}
+
+	// Salt is random, so the same key and password wrap differently
+	if WrapWithPassword(dataKey, "secret") == wrapped {
+		t.Fatal("wrapping twice gave the same result")
+	}
+
+	// Parameters are authenticated, lowering the cost makes unwrapping fail
+	parts := strings.Split(wrapped, "$")
+	parts[1] = "t=1,m=65536,p=4"
+	if _, err := UnwrapWithPassword(strings.Join(parts, "$"), "secret"); !errors.Is(err, ErrWrongPassword) {
+		t.Fatalf("unwrap with changed parameters: got %v, want ErrWrongPassword", err)
+	}
+
+	for _, malformed := range []string{"", "argon2id$t=3", "bcrypt$t=3,m=65536,p=4$a$b", "argon2id$x$a$b", "argon2id$t=3,m=65536,p=4$!$b", "argon2id$t=3,m=65536,p=4$YQ$YQ"} {
+		if _, err := UnwrapWithPassword(malformed, "secret"); err == nil || errors.Is(err, ErrWrongPassword) {
+			t.Fatalf("%q: got %v, want malformed", malformed, err)
+		}
+	}
+}
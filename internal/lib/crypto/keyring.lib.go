@@ -0,0 +1,102 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// KeySize is the size of master and data keys, selecting AES-256.
+const KeySize = 32
+
+var ErrUnknownKey = errors.New("crypto: data key is wrapped by an unknown master key")
+
+// Keyring holds master keys by id, the current one wraps new data keys and the others only unwrap old ones.
+type Keyring struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+// ParseKeyring parses master keys given as "id:base64", the first is current. Nil keyring is returned for no keys.
+func ParseKeyring(specs []string) (*Keyring, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	k := &Keyring{keys: map[string]cipher.AEAD{}}
+	for _, spec := range specs {
+		id, encoded, ok := strings.Cut(spec, ":")
+		if !ok || id == "" {
+			return nil, errors.New(`master key must look like "id:base64"`)
+		}
+		if _, ok := k.keys[id]; ok {
+			return nil, fmt.Errorf("master key id %q is used twice", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != KeySize {
+			return nil, fmt.Errorf("master key %q must be %v bytes in base64", id, KeySize)
+		}
+
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		k.keys[id] = aead
+		if k.current == "" {
+			k.current = id
+		}
+	}
+	return k, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// NewDataKey creates a random key encrypting content of one blob.
+func NewDataKey() []byte {
+	key := make([]byte, KeySize)
+	rand.Read(key)
+	return key
+}
+
+// Wrap encrypts data key with current master key, returning "id:base64" to be stored.
+func (k *Keyring) Wrap(dataKey []byte) string {
+	aead := k.keys[k.current]
+	nonce := make([]byte, aead.NonceSize())
+	rand.Read(nonce)
+
+	// Master key id is authenticated, so a wrapped key can't be passed off as another master key's
+	sealed := aead.Seal(nonce, nonce, dataKey, []byte(k.current))
+	return k.current + ":" + base64.StdEncoding.EncodeToString(sealed)
+}
+
+// Unwrap decrypts data key wrapped by any master key of keyring.
+func (k *Keyring) Unwrap(wrapped string) ([]byte, error) {
+	id, encoded, _ := strings.Cut(wrapped, ":")
+	aead, ok := k.keys[id]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return nil, errors.New("crypto: malformed wrapped data key")
+	}
+	n := aead.NonceSize()
+	return aead.Open(nil, sealed[:n], sealed[n:], []byte(id))
+}
+
+// IsCurrent reports whether wrapped data key is wrapped by current master key, otherwise it should be wrapped again.
+func (k *Keyring) IsCurrent(wrapped string) bool {
+	id, _, _ := strings.Cut(wrapped, ":")
+	return id == k.current
+}
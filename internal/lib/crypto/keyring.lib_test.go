@@ -0,0 +1,83 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func masterKey(id string) string {
+	return id + ":" + base64.StdEncoding.EncodeToString(NewDataKey())
+}
+
+func TestKeyringRotation(t *testing.T) {
+	old, current := masterKey("old"), masterKey("new")
+
+	before, err := ParseKeyring([]string{old})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataKey := NewDataKey()
+	wrapped := before.Wrap(dataKey)
+
+	// Old key is rotated out as current but kept to unwrap keys it wrapped
+	after, err := ParseKeyring([]string{current, old})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.IsCurrent(wrapped) {
+		t.Fatal("key wrapped by rotated out master key reported current")
+	}
+	got, err := after.Unwrap(wrapped)
+	if err != nil || !bytes.Equal(got, dataKey) {
+		t.Fatalf("unwrap by rotated out master key: %v", err)
+	}
+
+	rewrapped := after.Wrap(got)
+	if !after.IsCurrent(rewrapped) {
+		t.Fatal("rewrapped key not reported current")
+	}
+	if got, err := after.Unwrap(rewrapped); err != nil || !bytes.Equal(got, dataKey) {
+		t.Fatalf("unwrap rewrapped key: %v", err)
+	}
+
+	// Once dropped, the old master key can't unwrap anymore
+	dropped, _ := ParseKeyring([]string{current})
+	if _, err := dropped.Unwrap(wrapped); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("unwrap by dropped master key: got %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestKeyringWrongKey(t *testing.T) {
+	k, _ := ParseKeyring([]string{masterKey("a")})
+	wrapped := k.Wrap(NewDataKey())
+
+	// Same id with another key, and a wrapped key passed off as another id, both fail
+	other, _ := ParseKeyring([]string{masterKey("a")})
+	if _, err := other.Unwrap(wrapped); err == nil {
+		t.Fatal("unwrap by another key of same id succeeded")
+	}
+	_, sealed, _ := bytes.Cut([]byte(wrapped), []byte(":"))
+	renamed, _ := ParseKeyring([]string{masterKey("b"), "a:" + base64.StdEncoding.EncodeToString(NewDataKey())})
+	if _, err := renamed.Unwrap("b:" + string(sealed)); err == nil {
+		t.Fatal("unwrap of wrapped key passed off as another master key succeeded")
+	}
+}
+
+func TestParseKeyring(t *testing.T) {
+	if k, err := ParseKeyring(nil); k != nil || err != nil {
+		t.Fatalf("no keys: got %v, %v", k, err)
+	}
+	for _, specs := range [][]string{
+		{"nocolon"},
+		{":" + base64.StdEncoding.EncodeToString(NewDataKey())},
+		{"a:short"},
+		{"a:" + base64.StdEncoding.EncodeToString(make([]byte, 16))},
+		{masterKey("a"), masterKey("a")},
+	} {
+		if _, err := ParseKeyring(specs); err == nil {
+			t.Fatalf("%q: parsed", specs)
+		}
+	}
+}
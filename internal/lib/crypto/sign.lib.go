@@ -0,0 +1,20 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// Sign returns URL-safe HMAC-SHA256 of parts with key, parts must not contain NUL bytes.
+func Sign(key string, parts ...string) string {
+	m := hmac.New(sha256.New, []byte(key))
+	m.Write([]byte(strings.Join(parts, "\x00")))
+	return base64.RawURLEncoding.EncodeToString(m.Sum(nil))
+}
+
+// CompareSignature reports whether signature is the signature of parts with key, in constant time.
+func CompareSignature(key, signature string, parts ...string) bool {
+	return hmac.Equal([]byte(signature), []byte(Sign(key, parts...)))
+}
@@ -0,0 +1,187 @@
+package crypto
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Content is encrypted in segments, each sealed by AES-GCM with its index and whether it is the last one as nonce.
+// Segments can be decrypted on their own, so ranges are served without reading from the start,
+// while reordering, dropping or truncating segments fails authentication.
+const (
+	segmentSize = 64 * 1024
+	tagSize     = 16
+)
+
+var ErrCorrupted = errors.New("crypto: encrypted content is corrupted or truncated")
+
+// segments returns count of segments of content of size bytes, empty content still has one.
+func segments(size int64) int64 {
+	return max(1, (size+segmentSize-1)/segmentSize)
+}
+
+// segmentNonce is 11 bytes of big endian index followed by a byte flagging the last segment.
+func segmentNonce(nonce []byte, i int64, last bool) []byte {
+	clear(nonce)
+	binary.BigEndian.PutUint64(nonce[3:11], uint64(i))
+	if last {
+		nonce[11] = 1
+	}
+	return nonce
+}
+
+// EncryptedSize returns size of content of size bytes once encrypted.
+func EncryptedSize(size int64) int64 {
+	return size + segments(size)*tagSize
+}
+
+// Encrypter reads size bytes of plaintext from r encrypted with data key.
+type Encrypter struct {
+	r     io.Reader
+	aead  cipher.AEAD
+	size  int64
+	i     int64 // Index of next segment
+	nonce []byte
+	plain []byte
+	buf   []byte
+	out   []byte // Part of sealed segment in buf not yet read
+}
+
+func NewEncrypter(r io.Reader, dataKey []byte, size int64) (*Encrypter, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Encrypter{
+		r:     r,
+		aead:  aead,
+		size:  size,
+		nonce: make([]byte, aead.NonceSize()),
+		plain: make([]byte, segmentSize),
+		buf:   make([]byte, 0, segmentSize+tagSize),
+	}, nil
+}
+
+func (e *Encrypter) Read(p []byte) (int, error) {
+	if len(e.out) == 0 {
+		if e.i >= segments(e.size) {
+			return 0, io.EOF
+		}
+
+		n := min(segmentSize, e.size-e.i*segmentSize)
+		if _, err := io.ReadFull(e.r, e.plain[:n]); err != nil {
+			return 0, err
+		}
+		last := e.i == segments(e.size)-1
+		e.out = e.aead.Seal(e.buf[:0], segmentNonce(e.nonce, e.i, last), e.plain[:n], nil)
+		e.i++
+	}
+
+	n := copy(p, e.out)
+	e.out = e.out[n:]
+	return n, nil
+}
+
+// Decrypter reads plaintext of size bytes from encrypted content, opening it again from the needed segment after seeking.
+// It lets http.ServeContent serve ranges of encrypted content.
+type Decrypter struct {
+	open   func(offset int64) (io.ReadCloser, error) // Opens encrypted content from offset
+	aead   cipher.AEAD
+	size   int64
+	offset int64 // Plaintext position
+	r      io.ReadCloser
+	next   int64 // Index of segment r reads next
+	seg    int64 // Index of segment decrypted in plain
+	nonce  []byte
+	sealed []byte
+	plain  []byte
+}
+
+func NewDecrypter(open func(offset int64) (io.ReadCloser, error), dataKey []byte, size int64) (*Decrypter, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Decrypter{
+		open:   open,
+		aead:   aead,
+		size:   size,
+		seg:    -1,
+		nonce:  make([]byte, aead.NonceSize()),
+		sealed: make([]byte, segmentSize+tagSize),
+	}, nil
+}
+
+// load decrypts segment i into d.plain, reusing the open reader when reading sequentially.
+func (d *Decrypter) load(i int64) error {
+	if d.r == nil || d.next != i {
+		d.Close()
+		r, err := d.open(i * (segmentSize + tagSize))
+		if err != nil {
+			return err
+		}
+		d.r, d.next = r, i
+	}
+
+	n := min(segmentSize, d.size-i*segmentSize) + tagSize
+	if _, err := io.ReadFull(d.r, d.sealed[:n]); err != nil {
+		d.Close()
+		if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+			return ErrCorrupted
+		}
+		return err
+	}
+	d.next++
+
+	plain, err := d.aead.Open(d.plain[:0], segmentNonce(d.nonce, i, i == segments(d.size)-1), d.sealed[:n], nil)
+	if err != nil {
+		d.seg = -1
+		return ErrCorrupted
+	}
+	d.plain, d.seg = plain, i
+	return nil
+}
+
+func (d *Decrypter) Read(p []byte) (int, error) {
+	if d.offset >= d.size {
+		return 0, io.EOF
+	}
+
+	i := d.offset / segmentSize
+	if i != d.seg {
+		if err := d.load(i); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.plain[d.offset-i*segmentSize:])
+	d.offset += int64(n)
+	return n, nil
+}
+
+func (d *Decrypter) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += d.offset
+	case io.SeekEnd:
+		offset += d.size
+	}
+	if offset < 0 {
+		return 0, errors.New("crypto: negative seek position")
+	}
+
+	// Reader is kept, it is only opened again if another segment is needed
+	d.offset = offset
+	return offset, nil
+}
+
+func (d *Decrypter) Close() error {
+	if d.r == nil {
+		return nil
+	}
+	err := d.r.Close()
+	d.r = nil
+	return err
+}
@@ -0,0 +1,129 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+func encrypt(t *testing.T, key, plain []byte) []byte {
+	t.Helper()
+	e, err := NewEncrypter(bytes.NewReader(plain), key, int64(len(plain)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := io.ReadAll(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int64(len(sealed)) != EncryptedSize(int64(len(plain))) {
+		t.Fatalf("encrypted %d bytes to %d, want %d", len(plain), len(sealed), EncryptedSize(int64(len(plain))))
+	}
+	return sealed
+}
+
+// decrypter decrypts sealed content of size bytes, opening it from memory like storage would.
+func decrypter(t *testing.T, key, sealed []byte, size int64) *Decrypter {
+	t.Helper()
+	open := func(offset int64) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(sealed[min(offset, int64(len(sealed))):])), nil
+	}
+	d, err := NewDecrypter(open, key, size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Close() })
+	return d
+}
+
+func TestStreamRoundTrip(t *testing.T) {
+	key := NewDataKey()
+	for _, size := range []int{0, 1, segmentSize - 1, segmentSize, segmentSize + 1, 3 * segmentSize, 3*segmentSize + 1} {
+		plain := make([]byte, size)
+		rand.Read(plain)
+
+		got, err := io.ReadAll(decrypter(t, key, encrypt(t, key, plain), int64(size)))
+		if err != nil {
+			t.Fatalf("size %d: %v", size, err)
+		}
+		if !bytes.Equal(got, plain) {
+			t.Fatalf("size %d: decrypted content differs", size)
+		}
+	}
+}
+
+func TestStreamSeek(t *testing.T) {
+	key := NewDataKey()
+	plain := make([]byte, 3*segmentSize+100)
+	rand.Read(plain)
+	d := decrypter(t, key, encrypt(t, key, plain), int64(len(plain)))
+
+	// Partial reads around and across segment boundaries, also going back to an earlier segment
+	for _, r := range []struct{ offset, n int64 }{
+		{segmentSize - 10, 20},
+		{2*segmentSize + 5, 1000},
+		{10, 10},
+		{3 * segmentSize, 100},
+		{segmentSize, segmentSize},
+	} {
+		if _, err := d.Seek(r.offset, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		got := make([]byte, r.n)
+		if _, err := io.ReadFull(d, got); err != nil {
+			t.Fatalf("read %d bytes at %d: %v", r.n, r.offset, err)
+		}
+		if !bytes.Equal(got, plain[r.offset:r.offset+r.n]) {
+			t.Fatalf("read %d bytes at %d: content differs", r.n, r.offset)
+		}
+	}
+
+	if pos, _ := d.Seek(-50, io.SeekEnd); pos != int64(len(plain))-50 {
+		t.Fatalf("seek from end: got %d", pos)
+	}
+	if rest, err := io.ReadAll(d); err != nil || !bytes.Equal(rest, plain[len(plain)-50:]) {
+		t.Fatalf("read after seek from end: %d bytes, %v", len(rest), err)
+	}
+	if _, err := d.Seek(-1, io.SeekStart); err == nil {
+		t.Fatal("negative seek succeeded")
+	}
+}
+
+func TestStreamCorrupted(t *testing.T) {
+	key := NewDataKey()
+	plain := make([]byte, 2*segmentSize+100)
+	rand.Read(plain)
+	sealed := encrypt(t, key, plain)
+	size := int64(len(plain))
+
+	tampered := bytes.Clone(sealed)
+	tampered[segmentSize+tagSize+1] ^= 1
+	swapped := bytes.Clone(sealed)
+	copy(swapped, sealed[segmentSize+tagSize:2*(segmentSize+tagSize)])
+	copy(swapped[segmentSize+tagSize:], sealed[:segmentSize+tagSize])
+
+	for name, c := range map[string]struct {
+		sealed []byte
+		size   int64
+	}{
+		"tampered":  {tampered, size},
+		"swapped":   {swapped, size},
+		"truncated": {sealed[:len(sealed)-1], size},
+		// Dropping the last segment leaves a segment not flagged as last where the last one is expected
+		"dropped":   {sealed[:2*(segmentSize+tagSize)], 2 * segmentSize},
+		"wrong key": {encrypt(t, NewDataKey(), plain), size},
+	} {
+		if _, err := io.ReadAll(decrypter(t, key, c.sealed, c.size)); !errors.Is(err, ErrCorrupted) {
+			t.Fatalf("%s: got %v, want ErrCorrupted", name, err)
+		}
+	}
+
+	// A tampered segment fails even when reached by seeking past intact ones
+	d := decrypter(t, key, tampered, size)
+	d.Seek(segmentSize+10, io.SeekStart)
+	if _, err := d.Read(make([]byte, 10)); !errors.Is(err, ErrCorrupted) {
+		t.Fatalf("seek to tampered segment: got %v, want ErrCorrupted", err)
+	}
+}
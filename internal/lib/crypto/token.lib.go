@@ -2,16 +2,15 @@ package crypto
 
 import (
 	"crypto/rand"
-	"file-sharing/config"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"math/big"
 )
 
-func CreateToken() string {
-	const (
-		length  = config.TOKEN_LENGTH
-		charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
-	)
+const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 
+func createRandomString(length int) string {
 	b := make([]byte, length)
 	for i := range b {
 		n, _ := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
@@ -19,3 +18,22 @@ func CreateToken() string {
 	}
 	return string(b)
 }
+
+func CreateToken(length int) string {
+	return createRandomString(length)
+}
+
+// CreateSecret creates a long random token for secrets such as management token.
+func CreateSecret(length int) string {
+	return createRandomString(length)
+}
+
+// HashToken hashes high entropy token with SHA-256, unlike HashPassword it is fast and deterministic.
+func HashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+func CompareToken(hashed, token string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashed), []byte(HashToken(token))) == 1
+}
@@ -0,0 +1,33 @@
+package filelib
+
+import (
+	"file-sharing/ent"
+	"file-sharing/internal/lib/crypto"
+	"time"
+)
+
+func IsBundleExpired(bundle *ent.Bundle) bool {
+	return !bundle.ExpiresAt.After(time.Now())
+}
+
+func IsBundlePasswordCorrect(bundle *ent.Bundle, password string) bool {
+	return bundle.Password == nil || crypto.ComparePassword(*bundle.Password, password)
+}
+
+func IsBundleManageTokenCorrect(bundle *ent.Bundle, token string) bool {
+	return crypto.CompareToken(bundle.ManageToken, token)
+}
+
+func IsBundleOwner(bundle *ent.Bundle, user *ent.User) bool {
+	return user != nil && bundle.OwnerID != nil && *bundle.OwnerID == user.ID
+}
+
+// HasBundleDownloadSlot reports whether bundle has not reached its max downloads.
+func HasBundleDownloadSlot(bundle *ent.Bundle) bool {
+	return bundle.MaxDownloads == nil || bundle.DownloadCount < *bundle.MaxDownloads
+}
+
+// IsSharingBundlePassword reports whether member file is protected by the same password as bundle, or by none.
+func IsSharingBundlePassword(file *ent.File, bundle *ent.Bundle) bool {
+	return file.Password == nil || (bundle.Password != nil && *file.Password == *bundle.Password)
+}
@@ -0,0 +1,100 @@
+package filelib
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"file-sharing/ent"
+	"hash"
+	"io"
+	"os"
+	"strings"
+)
+
+// Checksum holds hex digests of file content.
+type Checksum struct {
+	SHA256 string
+	MD5    string
+}
+
+type hasher struct {
+	sha256 hash.Hash
+	md5    hash.Hash
+}
+
+func newHasher() *hasher {
+	return &hasher{sha256.New(), md5.New()}
+}
+
+func (h *hasher) Write(p []byte) (int, error) {
+	h.sha256.Write(p)
+	h.md5.Write(p)
+	return len(p), nil
+}
+
+func (h *hasher) checksum() *Checksum {
+	return &Checksum{
+		SHA256: hex.EncodeToString(h.sha256.Sum(nil)),
+		MD5:    hex.EncodeToString(h.md5.Sum(nil)),
+	}
+}
+
+// WriteHashed streams src into a new file at dst, returning checksum and size of written content.
+func WriteHashed(src io.Reader, dst string) (*Checksum, int64, error) {
+	f, err := os.Create(dst)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+
+	h := newHasher()
+	size, err := io.Copy(io.MultiWriter(f, h), src)
+	if err != nil {
+		return nil, 0, err
+	}
+	return h.checksum(), size, f.Sync()
+}
+
+// HashFile returns checksum of file at path.
+func HashFile(path string) (*Checksum, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := newHasher()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	return h.checksum(), nil
+}
+
+// Verify compares checksum against optional hex digests expected by client.
+func (c *Checksum) Verify(expectedSHA256, expectedMD5 string) error {
+	if expectedSHA256 != "" && !strings.EqualFold(expectedSHA256, c.SHA256) {
+		return errors.New("SHA-256 checksum mismatch, file may be corrupted during upload")
+	}
+	if expectedMD5 != "" && !strings.EqualFold(expectedMD5, c.MD5) {
+		return errors.New("MD5 checksum mismatch, file may be corrupted during upload")
+	}
+	return nil
+}
+
+// GetDigest returns value of Digest header (RFC 3230) of file, empty for files uploaded before checksums existed.
+func GetDigest(file *ent.File) string {
+	digests := []string{}
+	if file.Sha256 != nil {
+		if b, err := hex.DecodeString(*file.Sha256); err == nil {
+			digests = append(digests, "sha-256="+base64.StdEncoding.EncodeToString(b))
+		}
+	}
+	if file.Md5 != nil {
+		if b, err := hex.DecodeString(*file.Md5); err == nil {
+			digests = append(digests, "md5="+base64.StdEncoding.EncodeToString(b))
+		}
+	}
+	return strings.Join(digests, ",")
+}
@@ -1,11 +1,14 @@
 package filelib
 
 import (
+	"context"
 	"encoding/json"
 	"file-sharing/config"
 	"file-sharing/ent"
+	"file-sharing/internal/storage"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"time"
 )
@@ -14,6 +17,7 @@ const DateFormat = "2006-01-02_15-04-05"
 
 type FileInfo struct {
 	Name         string `json:"fileName"`
+	Token        string `json:"token,omitempty"`
 	Types        string `json:"type"`
 	Extension    string `json:"extension,omitempty"`
 	LastModified string `json:"lastModified,omitempty"`
@@ -25,47 +29,43 @@ type DeleteLog struct {
 	Small []*FileInfo `json:"smallSize"`
 }
 
-func GetType(f os.DirEntry) string {
-	if f.IsDir() {
-		return "directory"
-	}
-	return "file"
-}
-
-func CreateFileInfo(entries []os.DirEntry) []*FileInfo {
+func CreateFileInfo(entries []*storage.Info) []*FileInfo {
 	result := []*FileInfo{}
 	for _, f := range entries {
-		i, err := f.Info()
-		lastModif := ""
-		size := ""
-		if err == nil {
-			lastModif = i.ModTime().Format(DateFormat)
-			if !f.IsDir() {
-				size = fmt.Sprintf("%.2fMB", float64(i.Size())/float64(config.MB))
-			}
-		}
+		name := path.Base(f.Key)
 		result = append(result, &FileInfo{
-			Name:         f.Name(),
-			Types:        GetType(f),
-			Extension:    GetExtension(f.Name()),
-			LastModified: lastModif,
-			Size:         size,
+			Name:         name,
+			Types:        "file",
+			Extension:    GetExtension(name),
+			LastModified: f.ModTime.Format(DateFormat),
+			Size:         fmt.Sprintf("%.2fMB", float64(f.Size)/float64(config.MB)),
 		})
 	}
 	return result
 }
 
-func ReadDeleteDir() (large, small []os.DirEntry, err error) {
-	err = CreateDir() // prevent error on read
-	if err != nil {
-		return nil, nil, err
+// CreateFileInfoOfFiles describes deleted files by their name and share token, as content of deduplicated files is stored by hash.
+func CreateFileInfoOfFiles(files []*ent.File) []*FileInfo {
+	result := []*FileInfo{}
+	for _, f := range files {
+		result = append(result, &FileInfo{
+			Name:         f.FileName,
+			Token:        f.Token,
+			Types:        "file",
+			Extension:    GetExtension(f.FileName),
+			LastModified: f.CreatedAt.Format(DateFormat),
+			Size:         fmt.Sprintf("%.2fMB", float64(f.FileSize)/float64(config.MB)),
+		})
 	}
+	return result
+}
 
-	large, err = os.ReadDir(config.LARGE_PATH)
+func ReadDeleteDir(ctx context.Context, st storage.Storage) (large, small []*storage.Info, err error) {
+	large, err = st.List(ctx, config.LARGE_DIR+"/")
 	if err != nil {
 		return nil, nil, err
 	}
-	small, err = os.ReadDir(config.SMALL_PATH)
+	small, err = st.List(ctx, config.SMALL_DIR+"/")
 	if err != nil {
 		return nil, nil, err
 	}
@@ -92,37 +92,19 @@ func CreateDeleteLogFile(large, small []*FileInfo, path string) (*os.File, error
 	return file, nil
 }
 
-func CreateDeleteLog(files []*ent.File, path string) error {
-	large, small, err := ReadDeleteDir()
-	if err != nil {
-		return err
-	}
-
-	ali := CreateFileInfo(large)
-	asi := CreateFileInfo(small)
-
-	li := []*FileInfo{}
-	si := []*FileInfo{}
+func CreateDeleteLog(cfg *config.Config, files []*ent.File, path string) error {
+	large := []*ent.File{}
+	small := []*ent.File{}
 
 	for _, file := range files {
-		if GetPathBySize(file.FileSize) == config.LARGE_PATH {
-			for _, i := range ali {
-				if i.Name == file.FileName {
-					li = append(li, i)
-					break
-				}
-			}
+		if GetDirBySize(cfg, file.FileSize) == config.LARGE_DIR {
+			large = append(large, file)
 		} else {
-			for _, i := range asi {
-				if i.Name == file.FileName {
-					si = append(si, i)
-					break
-				}
-			}
+			small = append(small, file)
 		}
 	}
 
-	if _, err := CreateDeleteLogFile(li, si, path); err != nil {
+	if _, err := CreateDeleteLogFile(CreateFileInfoOfFiles(large), CreateFileInfoOfFiles(small), path); err != nil {
 		return err
 	}
 
@@ -8,26 +8,26 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
 )
 
-func GetPathBySize(size int64) string {
-	threshold := int64(config.SAVE_SPLIT) * config.MB
+func GetDirBySize(cfg *config.Config, size int64) string {
+	threshold := cfg.SaveSplit * config.MB
 	if size > threshold {
-		return config.LARGE_PATH
+		return config.LARGE_DIR
 	}
-	return config.SMALL_PATH
+	return config.SMALL_DIR
 }
 
-func CreateDir() error {
-	if err := os.MkdirAll(config.SMALL_PATH, 0755); err != nil {
-		log.Printf("Error creating %v directories:\n%v", config.SMALL_PATH, err)
-		return err
-	}
-
-	if err := os.MkdirAll(config.LARGE_PATH, 0755); err != nil {
-		log.Printf("Error creating %v directories:\n%v", config.LARGE_PATH, err)
+// CreateDir creates local directory for incomplete and temporary uploads, stored files are created by storage.
+func CreateDir(cfg *config.Config) error {
+	if err := os.MkdirAll(cfg.PartialPath(), 0755); err != nil {
+		log.Printf("Error creating %v directories:\n%v", cfg.PartialPath(), err)
 		return err
 	}
 	return nil
@@ -41,8 +41,41 @@ func GetExtension(filename string) string {
 	return filename[i+1:]
 }
 
-func GetPathname(file *ent.File) string {
-	return filepath.Join(GetPathBySize(file.FileSize), fmt.Sprintf("%v##%v", file.Token, file.FileName))
+// IsValidFileName reports whether name can be used as stored file name.
+func IsValidFileName(name string) bool {
+	return strings.TrimSpace(name) != "" && !strings.ContainsAny(name, `/\`)
+}
+
+// GetKey returns storage key of file content.
+func GetKey(cfg *config.Config, file *ent.File) string {
+	if file.BlobID != nil {
+		return GetBlobKey(cfg, *file.BlobID, file.FileSize)
+	}
+	// Files uploaded before content-addressed storage, or encrypted by password so never shared
+	return path.Join(GetDirBySize(cfg, file.FileSize), fmt.Sprintf("%v##%v", file.Token, file.FileName))
+}
+
+// GetBlobKey returns storage key of content-addressed blob.
+func GetBlobKey(cfg *config.Config, id string, size int64) string {
+	return path.Join(GetDirBySize(cfg, size), id)
+}
+
+// GetTempPathname returns a unique local path for data being received.
+func GetTempPathname(cfg *config.Config) string {
+	return filepath.Join(cfg.PartialPath(), "tmp-"+uuid.New().String())
+}
+
+// GetPartialPathname returns path of incomplete resumable upload data.
+func GetPartialPathname(cfg *config.Config, upload *ent.Upload) string {
+	return filepath.Join(cfg.PartialPath(), upload.ID)
+}
+
+// GetETag returns strong entity tag of file content, content of a file never changes after upload.
+func GetETag(file *ent.File) string {
+	if file.Sha256 != nil {
+		return `"` + *file.Sha256 + `"`
+	}
+	return `"` + file.ID + `"`
 }
 
 func ExtractFileName(path string) (string, error) {
@@ -57,17 +90,36 @@ func ExtractFileName(path string) (string, error) {
 	return s, nil
 }
 
+func IsExpired(file *ent.File) bool {
+	return !file.ExpiresAt.After(time.Now())
+}
+
 func IsPasswordCorrect(file *ent.File, password string) bool {
 	return file.Password == nil || crypto.ComparePassword(*file.Password, password)
 }
 
-func IsDownloadable(file *ent.File, password string) (downloadable bool, cause string) {
-	if file.MaxDownloads != nil && file.DownloadCount > *file.MaxDownloads {
-		return false, "MAX_DOWNLOADS"
-	}
-	if !IsPasswordCorrect(file, password) {
-		return false, "PASSWORD"
-	}
-	return true, ""
+// IsPasswordEncrypted reports whether content of file can only be decrypted with its password.
+func IsPasswordEncrypted(file *ent.File) bool {
+	return file.PasswordKey != nil
+}
+
+// IsClientEncrypted reports whether content of file was encrypted by client before upload, server never sees its key.
+func IsClientEncrypted(file *ent.File) bool {
+	return file.Encryption != nil && *file.Encryption == "client"
+}
+
+// IsManageTokenCorrect reports whether token authorizes managing file, files uploaded before management token existed have none.
+func IsManageTokenCorrect(file *ent.File, token string) bool {
+	return file.ManageToken != nil && crypto.CompareToken(*file.ManageToken, token)
+}
+
+// IsOwner reports whether user uploaded file, anonymous uploads have no owner.
+func IsOwner(file *ent.File, user *ent.User) bool {
+	return user != nil && file.OwnerID != nil && *file.OwnerID == user.ID
+}
+
+// HasDownloadSlot reports whether file has not reached its max downloads.
+func HasDownloadSlot(file *ent.File) bool {
+	return file.MaxDownloads == nil || file.DownloadCount < *file.MaxDownloads
 }
 
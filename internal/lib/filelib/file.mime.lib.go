@@ -0,0 +1,63 @@
+package filelib
+
+import (
+	"file-sharing/config"
+	"mime"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
+)
+
+// DetectMime sniffs type of content at path from its first bytes, refined by extension of name only towards a subtype,
+// e.g. text/plain content named "data.csv" is text/csv, while an executable named "photo.jpg" stays an executable.
+func DetectMime(path, name string) (*mimetype.MIME, error) {
+	detected, err := mimetype.DetectFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ext := GetExtension(name)
+	if ext == "" {
+		return detected, nil
+	}
+	byExt, _, err := mime.ParseMediaType(mime.TypeByExtension("." + ext))
+	if err != nil {
+		return detected, nil
+	}
+	for m := mimetype.Lookup(byExt); m != nil; m = m.Parent() {
+		if m.Is(detected.String()) {
+			return mimetype.Lookup(byExt), nil
+		}
+	}
+	return detected, nil
+}
+
+// MimeEssence returns type of m without parameters, e.g. "text/plain" of "text/plain; charset=utf-8".
+func MimeEssence(m *mimetype.MIME) string {
+	essence, _, _ := strings.Cut(m.String(), ";")
+	return essence
+}
+
+// MatchesMime reports whether m or a type it is a kind of matches a pattern, either a type or "type/*".
+// Content nothing is known about (application/octet-stream) is a kind of nothing else.
+func MatchesMime(patterns []string, m *mimetype.MIME) bool {
+	for t := m; t != nil; t = t.Parent() {
+		if t != m && t.Parent() == nil {
+			break
+		}
+		for _, p := range patterns {
+			if prefix, ok := strings.CutSuffix(p, "*"); ok && strings.HasPrefix(MimeEssence(t), prefix) || t.Is(p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsMimeAllowed reports whether content of type m may be uploaded, denied types win over allowed ones.
+func IsMimeAllowed(cfg *config.Config, m *mimetype.MIME) bool {
+	if len(cfg.AllowedMimes) > 0 && !MatchesMime(cfg.AllowedMimes, m) {
+		return false
+	}
+	return !MatchesMime(cfg.DeniedMimes, m)
+}
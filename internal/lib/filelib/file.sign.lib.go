@@ -0,0 +1,72 @@
+package filelib
+
+import (
+	"file-sharing/internal/lib/crypto"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignDownload returns signature allowing download of record id without password until expires.
+// Password hash is signed along, so changing the password revokes issued signatures.
+func SignDownload(key, id string, password *string, expires time.Time) string {
+	return crypto.Sign(key, "download", id, pwOrEmpty(password), strconv.FormatInt(expires.Unix(), 10))
+}
+
+// IsDownloadSignatureValid verifies signature from SignDownload, expires is in unix seconds as sent in signed URL.
+func IsDownloadSignatureValid(key, id string, password *string, expires, signature string) bool {
+	t, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > t {
+		return false
+	}
+	return crypto.CompareSignature(key, signature, "download", id, pwOrEmpty(password), expires)
+}
+
+// SignClaim returns claim of a counted download of record id, as "<expires>.<signature>".
+// It is bound to content by etag and to the way of download by scope, e.g. nonce of a presigned link.
+func SignClaim(key, id, etag, scope string, expires time.Time) string {
+	e := strconv.FormatInt(expires.Unix(), 10)
+	return e + "." + crypto.Sign(key, "claim", id, etag, scope, e)
+}
+
+// IsClaimValid verifies claim from SignClaim has not expired.
+func IsClaimValid(key, id, etag, scope, claim string) bool {
+	expires, signature, ok := strings.Cut(claim, ".")
+	if !ok {
+		return false
+	}
+	t, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > t {
+		return false
+	}
+	return crypto.CompareSignature(key, signature, "claim", id, etag, scope, expires)
+}
+
+func pwOrEmpty(password *string) string {
+	if password == nil {
+		return ""
+	}
+	return *password
+}
+
+// Query fields of presigned link covered by its signature
+var linkFields = []string{"expires", "ip", "max", "nonce"}
+
+func linkParts(id string, q url.Values) []string {
+	parts := []string{"link", id}
+	for _, k := range linkFields {
+		parts = append(parts, k+"="+q.Get(k))
+	}
+	return parts
+}
+
+// SignLink returns signature of presigned link to file id, along with its constraints in q.
+func SignLink(key, id string, q url.Values) string {
+	return crypto.Sign(key, linkParts(id, q)...)
+}
+
+// IsLinkSignatureValid verifies "signature" in q is from SignLink, expiry is not checked.
+func IsLinkSignatureValid(key, id string, q url.Values) bool {
+	return crypto.CompareSignature(key, q.Get("signature"), linkParts(id, q)...)
+}
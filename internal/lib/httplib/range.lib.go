@@ -0,0 +1,76 @@
+package httplib
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IsNotModified reports whether conditional headers of r make http.ServeContent reply 304 Not Modified.
+func IsNotModified(r *http.Request, etag string, modtime time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, t := range strings.Split(inm, ",") {
+			t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+			if t == "*" || t == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	ims, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	return err == nil && !modtime.Truncate(time.Second).After(ims)
+}
+
+// IsRangeApplied reports whether Range header of r is honored, a failed If-Range makes the full content sent instead.
+func IsRangeApplied(r *http.Request, etag string, modtime time.Time) bool {
+	if r.Header.Get("Range") == "" {
+		return false
+	}
+
+	ir := r.Header.Get("If-Range")
+	if ir == "" {
+		return true
+	}
+	if strings.HasPrefix(ir, `"`) {
+		return ir == etag
+	}
+	t, err := http.ParseTime(ir)
+	return err == nil && modtime.Truncate(time.Second).Equal(t)
+}
+
+// IsResuming reports whether Range header of r is honored and every range starts after the first byte of content of size,
+// so the whole content can't be fetched again. Ranges not parsing are not resuming.
+func IsResuming(r *http.Request, etag string, modtime time.Time, size int64) bool {
+	if !IsRangeApplied(r, etag, modtime) {
+		return false
+	}
+	spec, ok := strings.CutPrefix(strings.TrimSpace(r.Header.Get("Range")), "bytes=")
+	if !ok {
+		return false
+	}
+
+	for _, rng := range strings.Split(spec, ",") {
+		first, last, ok := strings.Cut(strings.TrimSpace(rng), "-")
+		if !ok {
+			return false
+		}
+		// Suffix range "-n" starts n bytes before the end
+		start, err := strconv.ParseInt(first, 10, 64)
+		if first == "" {
+			var n int64
+			n, err = strconv.ParseInt(last, 10, 64)
+			start = size - n
+		}
+		if err != nil || start <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsContentSent reports whether http.ServeContent answers r with content, HEAD requests and those answered 304 carry none.
+func IsContentSent(r *http.Request, etag string, modtime time.Time) bool {
+	return r.Method != http.MethodHead && !IsNotModified(r, etag, modtime)
+}
@@ -0,0 +1,41 @@
+package pagelib
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("Invalid cursor, please use next_cursor from previous page")
+
+// Cursor points after the last item of a page in keyset pagination.
+// It carries sorting of the page, so the next page can't be requested with a different one.
+type Cursor struct {
+	Sort  string `json:"s"`
+	Order string `json:"o"`
+	Value any    `json:"v"`  // Sort field value of the last item
+	ID    string `json:"id"` // ID of the last item, breaking ties of equal values
+}
+
+// Encode returns cursor as an opaque URL-safe string.
+func (c *Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func Decode(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	// Keep numbers exact, so int64 values survive the round trip
+	var c Cursor
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&c); err != nil || c.ID == "" || c.Value == nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
@@ -0,0 +1,106 @@
+package ratelib
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter keeps an in-memory token bucket per key, a nil Limiter allows everything.
+type Limiter struct {
+	mu      sync.Mutex
+	rate    float64 // Tokens refilled per second
+	burst   float64
+	buckets map[string]*bucket
+	sweptAt time.Time
+}
+
+type bucket struct {
+	tokens float64
+	at     time.Time // Last refill
+}
+
+// New returns a limiter refilled by perMinute tokens a minute up to burst, nil if perMinute is not positive.
+func New(perMinute, burst int) *Limiter {
+	if perMinute <= 0 {
+		return nil
+	}
+	return &Limiter{
+		rate:    float64(perMinute) / 60,
+		burst:   float64(burst),
+		buckets: map[string]*bucket{},
+		sweptAt: time.Now(),
+	}
+}
+
+// PRIVATE UTIL
+
+// refill returns bucket of key with tokens gained since last refill, l.mu must be held.
+func (l *Limiter) refill(key string, now time.Time) *bucket {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{l.burst, now}
+		l.buckets[key] = b
+		return b
+	}
+	b.tokens = min(l.burst, b.tokens+now.Sub(b.at).Seconds()*l.rate)
+	b.at = now
+	return b
+}
+
+// wait returns how long b needs to gain a whole token.
+func (l *Limiter) wait(b *bucket) time.Duration {
+	return time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+}
+
+// sweep drops buckets full again, as they are the same as new ones, l.mu must be held.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.sweptAt) < time.Minute {
+		return
+	}
+	l.sweptAt = now
+
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.at).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// UTIL
+
+// Allow takes a token of key, otherwise it returns how long to wait for one.
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	if l == nil {
+		return true, 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.sweep(now)
+	b := l.refill(key, now)
+	if b.tokens < 1 {
+		return false, l.wait(b)
+	}
+	b.tokens--
+	return true, 0
+}
+
+// Wait returns how long key has to wait for a token without taking it, zero if one is left.
+func (l *Limiter) Wait(key string) time.Duration {
+	if l == nil {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if _, ok := l.buckets[key]; !ok {
+		return 0
+	}
+	b := l.refill(key, now)
+	if b.tokens < 1 {
+		return l.wait(b)
+	}
+	return 0
+}
@@ -1,7 +1,10 @@
 package reply
 
 import (
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,8 +17,16 @@ import (
 
 // Meta represents metadata information of the API reply.
 type Meta struct {
-	Status      string `json:"status"`                // Overall reply status (e.g. "SUCCESS" or "ERROR")
-	Information string `json:"information,omitempty"` // Action information
+	Status      string      `json:"status"`                // Overall reply status (e.g. "SUCCESS" or "ERROR")
+	Information string      `json:"information,omitempty"` // Action information
+	Pagination  *Pagination `json:"pagination,omitempty"`  // Paging information of list replies
+}
+
+// Pagination represents paging information of list replies.
+type Pagination struct {
+	Total      int    `json:"total"`                 // Count of every item matching the query
+	Limit      int    `json:"limit"`                 // Max items in a page
+	NextCursor string `json:"next_cursor,omitempty"` // Cursor of next page, empty on last page
 }
 
 // ReplyEnvelope wraps the general structure of an API reply.
@@ -89,6 +100,18 @@ func (r *Reply) SetInfo(information string) *Reply {
 	return r
 }
 
+// SetRetryAfter sets "Retry-After" header in whole seconds, telling client when to try again.
+func (r *Reply) SetRetryAfter(d time.Duration) *Reply {
+	r.c.Header("Retry-After", strconv.Itoa(max(1, int(math.Ceil(d.Seconds())))))
+	return r
+}
+
+// SetPagination sets "pagination" in meta.
+func (r *Reply) SetPagination(pagination *Pagination) *Reply {
+	r.Payload.Meta.Pagination = pagination
+	return r
+}
+
 //
 // =======================
 // == High-level Helpers ==
@@ -166,15 +189,29 @@ func (r *Reply) Fail(code ...int) {
 //
 
 var (
-	CodeNotFound    = "NOT_FOUND"
-	CodeServerError = "SERVER_ERROR"
-	CodeBadRequest  = "CLIENT_ERROR"
-	CodeBadGateWay  = "BAD_GATEWAY"
+	CodeNotFound     = "NOT_FOUND"
+	CodeServerError  = "SERVER_ERROR"
+	CodeBadRequest   = "CLIENT_ERROR"
+	CodeBadGateWay   = "BAD_GATEWAY"
+	CodeExpired      = "EXPIRED"
+	CodeForbidden    = "FORBIDDEN"
+	CodeConflict     = "CONFLICT"
+	CodeUnauthorized = "UNAUTHORIZED"
+	CodeQuota        = "QUOTA_EXCEEDED"
+	CodeTooMany      = "TOO_MANY_REQUESTS"
+	CodeUnsupported  = "UNSUPPORTED_TYPE"
 )
 
 var codeAlias = map[string]int{
-	CodeNotFound:    http.StatusNotFound,
-	CodeServerError: http.StatusInternalServerError,
-	CodeBadRequest:  http.StatusBadRequest,
-	CodeBadGateWay:  http.StatusBadGateway,
+	CodeNotFound:     http.StatusNotFound,
+	CodeServerError:  http.StatusInternalServerError,
+	CodeBadRequest:   http.StatusBadRequest,
+	CodeBadGateWay:   http.StatusBadGateway,
+	CodeExpired:      http.StatusGone,
+	CodeForbidden:    http.StatusForbidden,
+	CodeConflict:     http.StatusConflict,
+	CodeUnauthorized: http.StatusUnauthorized,
+	CodeQuota:        http.StatusForbidden,
+	CodeTooMany:      http.StatusTooManyRequests,
+	CodeUnsupported:  http.StatusUnsupportedMediaType,
 }
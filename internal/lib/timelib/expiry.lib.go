@@ -0,0 +1,77 @@
+package timelib
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var dayUnit = regexp.MustCompile(`(\d+(?:\.\d+)?)([dw])`)
+
+// ParseDuration works like time.ParseDuration with additional "d" (day) and "w" (week) units, e.g. "3d" or "1w2d12h".
+func ParseDuration(s string) (time.Duration, error) {
+	var convErr error
+	s = dayUnit.ReplaceAllStringFunc(s, func(m string) string {
+		sm := dayUnit.FindStringSubmatch(m)
+		n, err := strconv.ParseFloat(sm[1], 64)
+		if err != nil {
+			convErr = err
+			return m
+		}
+		hours := n * 24
+		if sm[2] == "w" {
+			hours *= 7
+		}
+		return strconv.FormatFloat(hours, 'f', -1, 64) + "h"
+	})
+	if convErr != nil {
+		return 0, convErr
+	}
+	return time.ParseDuration(s)
+}
+
+// ParseExpiry resolves an expiry time from either a relative duration (expiresIn) or an absolute RFC3339 time (expiresAt).
+// Returns nil when neither is provided, maxDays limits how far the expiry can be.
+func ParseExpiry(expiresIn, expiresAt string, maxDays int) (*time.Time, error) {
+	if expiresIn != "" && expiresAt != "" {
+		return nil, errors.New("Please use either 'expires-in' or 'expires-at', not both")
+	}
+
+	now := time.Now()
+	var t time.Time
+	switch {
+	case expiresIn != "":
+		d, err := ParseDuration(expiresIn)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid 'expires-in' duration, use format like 30m, 1h or 3d")
+		}
+		t = now.Add(d)
+	case expiresAt != "":
+		at, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid 'expires-at' time, use RFC3339 format like 2006-01-02T15:04:05Z")
+		}
+		t = at
+	default:
+		return nil, nil
+	}
+
+	if err := ValidateExpiry(t, maxDays); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// ValidateExpiry makes sure t is in the future and within maxDays.
+func ValidateExpiry(t time.Time, maxDays int) error {
+	now := time.Now()
+	if !t.After(now) {
+		return errors.New("Expiry must be in the future")
+	}
+	if t.After(now.AddDate(0, 0, maxDays)) {
+		return fmt.Errorf("Max expiry is %v days", maxDays)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package tuslib
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+const (
+	Version    = "1.0.0"                           // Supported tus protocol version
+	Extensions = "creation,expiration,termination" // Supported tus protocol extensions
+	OffsetType = "application/offset+octet-stream" // Content type of PATCH request
+)
+
+// ParseMetadata decodes Upload-Metadata header which is comma separated "key base64value" pairs, value is optional.
+func ParseMetadata(header string) (map[string]string, error) {
+	meta := map[string]string{}
+	if strings.TrimSpace(header) == "" {
+		return meta, nil
+	}
+
+	for _, pair := range strings.Split(header, ",") {
+		kv := strings.Fields(pair)
+		switch len(kv) {
+		case 1:
+			meta[kv[0]] = ""
+		case 2:
+			v, err := base64.StdEncoding.DecodeString(kv[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid base64 value of metadata %q", kv[0])
+			}
+			meta[kv[0]] = string(v)
+		default:
+			return nil, fmt.Errorf("invalid metadata pair %q", pair)
+		}
+	}
+	return meta, nil
+}
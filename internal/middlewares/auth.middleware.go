@@ -0,0 +1,69 @@
+package middlewares
+
+import (
+	"errors"
+	"file-sharing/config"
+	"file-sharing/internal/lib/authlib"
+	"file-sharing/internal/lib/reply"
+	"file-sharing/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Authenticate resolves user of API key sent as bearer token, requests without a key stay anonymous.
+func Authenticate(us *services.User) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := authlib.GetBearer(c)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		u, err := us.Authenticate(c.Request.Context(), key)
+		if errors.Is(err, services.ErrInvalidKey) {
+			c.Header("WWW-Authenticate", "Bearer")
+			reply.New(c).Error(reply.CodeUnauthorized, "Invalid API key").Fail()
+			c.Abort()
+			return
+		}
+		if err != nil {
+			reply.New(c).Error(reply.CodeBadGateWay, err.Error()).Fail()
+			c.Abort()
+			return
+		}
+
+		authlib.SetUser(c, u)
+		c.Next()
+	}
+}
+
+// RequireUser rejects anonymous requests.
+func RequireUser() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if authlib.GetUser(c) == nil {
+			c.Header("WWW-Authenticate", "Bearer")
+			reply.New(c).Error(
+				reply.CodeUnauthorized,
+				"Please login first",
+				"Send API key in 'Authorization: Bearer <key>' header",
+			).Fail()
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireAdmin rejects requests of users not listed as admins, it must be used after RequireUser.
+func RequireAdmin(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !cfg.IsAdmin(authlib.GetUser(c).Username) {
+			reply.New(c).Error(reply.CodeForbidden, "Only admins can do this").Fail()
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,48 @@
+package middlewares
+
+import (
+	"file-sharing/config"
+	"file-sharing/internal/lib/ratelib"
+	"file-sharing/internal/lib/reply"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimit limits requests of every client IP and of every share token in ":token" route parameter.
+// Lookups of unknown tokens are limited separately per client IP, so guessing tokens is slowed down.
+func RateLimit(cfg *config.Config) gin.HandlerFunc {
+	byIP := ratelib.New(cfg.RateIP.Rate, cfg.RateIP.Burst)
+	byToken := ratelib.New(cfg.RateToken.Rate, cfg.RateToken.Burst)
+	misses := ratelib.New(cfg.RateMiss.Rate, cfg.RateMiss.Burst)
+
+	return func(c *gin.Context) {
+		ip := c.ClientIP()
+		token := c.Param("token")
+
+		if ok, wait := byIP.Allow(ip); !ok {
+			reply.New(c).Error(reply.CodeTooMany, "Too many requests, please slow down").SetRetryAfter(wait).Fail()
+			c.Abort()
+			return
+		}
+
+		if token != "" {
+			if wait := misses.Wait(ip); wait > 0 {
+				reply.New(c).Error(reply.CodeTooMany, "Too many unknown tokens, please try again later").SetRetryAfter(wait).Fail()
+				c.Abort()
+				return
+			}
+			if ok, wait := byToken.Allow(token); !ok {
+				reply.New(c).Error(reply.CodeTooMany, "Too many requests for this token, please try again later").SetRetryAfter(wait).Fail()
+				c.Abort()
+				return
+			}
+		}
+
+		c.Next()
+
+		if token != "" && c.Writer.Status() == http.StatusNotFound {
+			misses.Allow(ip)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package middlewares
+
+import (
+	"file-sharing/internal/lib/reply"
+	"file-sharing/internal/lib/tuslib"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Tus sets Tus-Resumable header on every response and rejects requests of unsupported protocol version.
+func Tus() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Tus-Resumable", tuslib.Version)
+
+		// OPTIONS is used for discovering server version, so it doesn't need to send any
+		if c.Request.Method != http.MethodOptions && c.GetHeader("Tus-Resumable") != tuslib.Version {
+			c.Header("Tus-Version", tuslib.Version)
+			reply.New(c).Error(
+				reply.CodeBadRequest,
+				"Unsupported tus protocol version",
+				"Send 'Tus-Resumable: "+tuslib.Version+"' header",
+			).Fail(http.StatusPreconditionFailed)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,13 @@
+package middlewares
+
+import "github.com/gin-gonic/gin"
+
+// StaticPage restricts static pages to scripts and requests of this origin, so nothing else can read keys in their URL.
+func StaticPage() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Content-Security-Policy", "default-src 'none'; script-src 'self'; style-src 'self'; connect-src 'self'; form-action 'none'; frame-ancestors 'none'; base-uri 'none'")
+		c.Header("Referrer-Policy", "no-referrer")
+		c.Header("X-Content-Type-Options", "nosniff")
+		c.Next()
+	}
+}
@@ -0,0 +1,21 @@
+package routers
+
+import (
+	"file-sharing/internal/handlers"
+	"file-sharing/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (r *Router) RegisterBundle(router *gin.Engine) {
+	fs := services.NewFile(r.dc, r.st, r.cfg)
+	bs := services.NewBundle(r.dc, fs, r.cfg)
+	bh := handlers.NewBundle(bs, r.cfg)
+
+	router.GET("/bundles/:token", bh.GetOne)
+	router.GET("/bundles/:token/download", bh.Download)
+	router.POST("/bundles/:token/download", bh.Download)
+	router.POST("/bundles/:token/download-url", bh.CreateDownloadURL)
+
+	router.DELETE("/bundles/:token", bh.DeleteOne)
+}
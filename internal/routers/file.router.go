@@ -8,14 +8,24 @@ import (
 )
 
 func (r *Router) RegisterFile(router *gin.Engine) {
-	fs := services.NewFile(r.dc)
-	fh := handlers.NewFile(fs)
+	fs := services.NewFile(r.dc, r.st, r.cfg)
+	bs := services.NewBundle(r.dc, fs, r.cfg)
+	ls := services.NewLink(r.dc, fs, r.cfg)
+	fh := handlers.NewFile(fs, bs, ls, r.cfg)
 
 	router.POST("/files", fh.CreateOne)
 
 	router.GET("/files", fh.GetMany)
 	router.GET("/files/:token", fh.GetOne)
 	router.GET("/files/:token/download", fh.Download)
+	router.HEAD("/files/:token/download", fh.Download)
+	router.POST("/files/:token/download", fh.Download)
+	router.POST("/files/:token/download-url", fh.CreateDownloadURL)
+	router.POST("/files/:token/links", fh.CreateLink)
+	router.GET("/files/presigned/:id", fh.DownloadLink)
+	router.HEAD("/files/presigned/:id", fh.DownloadLink)
+
+	router.PATCH("/files/:token", fh.UpdateOne)
 
 	router.DELETE("/files/:token", fh.DeleteOne)
 }
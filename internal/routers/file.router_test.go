@@ -0,0 +1,313 @@
+package routers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"file-sharing/config"
+	"file-sharing/ent"
+	"file-sharing/ent/file"
+	"file-sharing/internal/lib/filelib"
+	"file-sharing/internal/services/db"
+	"file-sharing/internal/storage"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	router, _ := newTestRouterWith(t)
+	return router
+}
+
+// newTestRouterWith returns router serving files along with the router registering more routes on it.
+func newTestRouterWith(t *testing.T) (*gin.Engine, *Router) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	dir := t.TempDir()
+	cfg := config.Default()
+	cfg.DBPath = filepath.Join(dir, "data.db")
+	cfg.UploadPath = filepath.Join(dir, "uploads")
+	cfg.DeleteLogPath = filepath.Join(dir, "logs")
+	cfg.SigningKey = "test-signing-key-of-at-least-32-characters"
+
+	client := db.Connect(cfg.DBPath, true)
+	t.Cleanup(func() { client.Close() })
+
+	st, err := storage.New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	router := gin.New()
+	r := New(client, st, cfg)
+	r.UseAuth(router)
+	r.RegisterFile(router)
+	return router, r
+}
+
+// upload uploads content with form fields and returns share token of the file.
+func upload(t *testing.T, router *gin.Engine, content []byte, fields map[string]string) string {
+	t.Helper()
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for k, v := range fields {
+		mw.WriteField(k, v)
+	}
+	fw, _ := mw.CreateFormFile("file", "a.bin")
+	fw.Write(content)
+	mw.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/files", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("upload: status %d, body %s", w.Code, w.Body)
+	}
+
+	var res struct {
+		Data struct {
+			Token string `json:"token"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil || res.Data.Token == "" {
+		t.Fatalf("upload: no token in %s", w.Body)
+	}
+	return res.Data.Token
+}
+
+func download(router *gin.Engine, token string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/files/"+token+"/download", nil)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestDownloadMaxDownloadsConcurrent(t *testing.T) {
+	// Every way of starting a download, including ranges from the middle, takes the slot
+	for name, header := range map[string]map[string]string{
+		"full":  nil,
+		"range": {"Range": "bytes=100-"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			router := newTestRouter(t)
+			token := upload(t, router, bytes.Repeat([]byte("x"), 4096), map[string]string{"max-downloads": "1"})
+
+			const n = 20
+			var wg sync.WaitGroup
+			codes := make(chan int, n)
+			for i := 0; i < n; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					codes <- download(router, token, header).Code
+				}()
+			}
+			wg.Wait()
+			close(codes)
+
+			served := 0
+			for code := range codes {
+				switch code {
+				case http.StatusOK, http.StatusPartialContent:
+					served++
+				case http.StatusBadRequest:
+				default:
+					t.Errorf("unexpected status %d", code)
+				}
+			}
+			if served != 1 {
+				t.Fatalf("served %d downloads of a file with max downloads 1, want 1", served)
+			}
+		})
+	}
+}
+
+func TestDownloadResumeWithClaim(t *testing.T) {
+	router := newTestRouter(t)
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	token := upload(t, router, content, map[string]string{"max-downloads": "1"})
+
+	w := download(router, token, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("first download: status %d", w.Code)
+	}
+	claim := w.Header().Get("X-Download-Claim")
+	if claim == "" {
+		t.Fatal("first download returned no claim")
+	}
+
+	// Dropped download resumes with its claim
+	w = download(router, token, map[string]string{"Range": "bytes=5000-", "X-Download-Claim": claim})
+	if w.Code != http.StatusPartialContent || !bytes.Equal(w.Body.Bytes(), content[5000:]) {
+		t.Fatalf("resume with claim: status %d, %d bytes", w.Code, w.Body.Len())
+	}
+
+	// Neither ranges without the claim nor whole content with it are served
+	if w := download(router, token, map[string]string{"Range": "bytes=5000-"}); w.Code != http.StatusBadRequest {
+		t.Fatalf("range without claim: status %d, want 400", w.Code)
+	}
+	for _, rng := range []string{"", "bytes=0-", "bytes=0-0,1-", "bytes=5000-,0-4999", "bytes=-10000", "bytes=-20000"} {
+		h := map[string]string{"X-Download-Claim": claim}
+		if rng != "" {
+			h["Range"] = rng
+		}
+		if w := download(router, token, h); w.Code != http.StatusBadRequest {
+			t.Fatalf("range %q with claim: status %d, want 400", rng, w.Code)
+		}
+	}
+	if w := download(router, token, map[string]string{"Range": "bytes=5000-", "X-Download-Claim": claim + "x"}); w.Code != http.StatusBadRequest {
+		t.Fatalf("range with forged claim: status %d, want 400", w.Code)
+	}
+}
+
+func TestDownloadFailureKeepsSlot(t *testing.T) {
+	router, r := newTestRouterWith(t)
+	ctx := context.Background()
+	token := upload(t, router, bytes.Repeat([]byte("x"), 4096), map[string]string{"max-downloads": "1"})
+
+	f, err := r.dc.File.Query().Where(file.Token(token)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.st.Delete(ctx, filelib.GetKey(r.cfg, f)); err != nil {
+		t.Fatal(err)
+	}
+
+	if w := download(router, token, nil); w.Code != http.StatusInternalServerError {
+		t.Fatalf("download of missing content: status %d, want 500", w.Code)
+	}
+	if f, err = r.dc.File.Get(ctx, f.ID); err != nil || f.DownloadCount != 0 {
+		t.Fatalf("failed download took a slot: download count %d, %v", f.DownloadCount, err)
+	}
+}
+
+// listPage lists public files with query and returns them along with cursor of next page.
+func listPage(t *testing.T, router *gin.Engine, query url.Values) ([]*ent.File, string) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/files?"+query.Encode(), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("list %v: status %d, body %s", query, w.Code, w.Body)
+	}
+
+	var res struct {
+		Meta struct {
+			Pagination struct {
+				NextCursor string `json:"next_cursor"`
+			} `json:"pagination"`
+		} `json:"meta"`
+		Data []*ent.File `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatalf("list %v: %v", query, err)
+	}
+	return res.Data, res.Meta.Pagination.NextCursor
+}
+
+func TestListCursor(t *testing.T) {
+	router, r := newTestRouterWith(t)
+	ctx := context.Background()
+
+	// Creation time is immutable in schema, so it is set beside ent
+	raw, err := sql.Open("sqlite3", "file:"+r.cfg.DBPath+"?_busy_timeout=5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer raw.Close()
+
+	// Every sort field has ties, so pages only stay apart if ties are broken the same way on every page
+	created := time.Now()
+	expires := created.Add(time.Hour)
+	for i := 0; i < 7; i++ {
+		token := upload(t, router, bytes.Repeat([]byte("x"), 10*(1+i%3)), map[string]string{"visibility": "public"})
+		err := r.dc.File.Update().Where(file.Token(token)).
+			SetFileName(fmt.Sprintf("file-%d.bin", i%2)).
+			SetMime([]string{"image/png", "text/plain"}[i%2]).
+			SetExpiresAt(expires.Add(time.Duration(i%3) * time.Microsecond)).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := raw.Exec("UPDATE files SET created_at = ? WHERE token = ?", created.Add(time.Duration(i/3)*time.Millisecond), token); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for sort, less := range map[string]func(a, b *ent.File) bool{
+		"created": func(a, b *ent.File) bool { return a.CreatedAt.Before(b.CreatedAt) },
+		"expires": func(a, b *ent.File) bool { return a.ExpiresAt.Before(b.ExpiresAt) },
+		"size":    func(a, b *ent.File) bool { return a.FileSize < b.FileSize },
+		"name":    func(a, b *ent.File) bool { return a.FileName < b.FileName },
+		"mime":    func(a, b *ent.File) bool { return a.Mime < b.Mime },
+	} {
+		for _, order := range []string{"asc", "desc"} {
+			seen := map[string]bool{}
+			var listed []*ent.File
+			query := url.Values{"sort": {sort}, "order": {order}, "limit": {"2"}}
+			for pages := 0; ; pages++ {
+				if pages > 7 {
+					t.Fatalf("%v %v: cursor never reached the last page", sort, order)
+				}
+				files, next := listPage(t, router, query)
+				for _, f := range files {
+					if seen[f.Token] {
+						t.Fatalf("%v %v: file %v listed twice", sort, order, f.Token)
+					}
+					seen[f.Token] = true
+				}
+				listed = append(listed, files...)
+				if next == "" {
+					break
+				}
+				query.Set("cursor", next)
+			}
+
+			if len(listed) != 7 {
+				t.Fatalf("%v %v: listed %d of 7 files", sort, order, len(listed))
+			}
+			for i := 1; i < len(listed); i++ {
+				a, b := listed[i-1], listed[i]
+				if order == "desc" {
+					a, b = b, a
+				}
+				if less(b, a) {
+					t.Fatalf("%v %v: file %d is out of order", sort, order, i)
+				}
+			}
+		}
+	}
+
+	// Cursor already points past the files an offset would skip
+	_, next := listPage(t, router, url.Values{"limit": {"2"}})
+	for _, query := range []url.Values{
+		{"cursor": {next}, "offset": {"2"}},
+		{"cursor": {next}, "sort": {"size"}},
+		{"cursor": {next}, "order": {"asc"}},
+		{"cursor": {"garbage"}},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/files?"+query.Encode(), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("list %v: status %d, want 400", query, w.Code)
+		}
+	}
+}
@@ -1,11 +1,25 @@
 package routers
 
-import "file-sharing/ent"
+import (
+	"file-sharing/config"
+	"file-sharing/ent"
+	"file-sharing/internal/middlewares"
+	"file-sharing/internal/storage"
+
+	"github.com/gin-gonic/gin"
+)
 
 type Router struct {
-	dc *ent.Client
+	dc  *ent.Client
+	st  storage.Storage
+	cfg *config.Config
+}
+
+func New(dbClient *ent.Client, st storage.Storage, cfg *config.Config) *Router {
+	return &Router{dbClient, st, cfg}
 }
 
-func New(dbClient *ent.Client) *Router {
-	return &Router{dbClient}
+// UseRateLimit limits requests of clients, it must be used before registering other routes.
+func (r *Router) UseRateLimit(router *gin.Engine) {
+	router.Use(middlewares.RateLimit(r.cfg))
 }
@@ -0,0 +1,23 @@
+package routers
+
+import (
+	"file-sharing/internal/handlers"
+	"file-sharing/internal/middlewares"
+	"file-sharing/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterUpload registers resumable uploads served by us, which must be shared with the reaper so both lock the same sessions.
+func (r *Router) RegisterUpload(router *gin.Engine, us *services.Upload) {
+	uh := handlers.NewUpload(us)
+
+	g := router.Group("/uploads", middlewares.Tus())
+
+	g.OPTIONS("", uh.Options)
+	g.POST("", uh.CreateOne)
+
+	g.HEAD("/:id", uh.GetOffset)
+	g.PATCH("/:id", uh.Append)
+	g.DELETE("/:id", uh.DeleteOne)
+}
@@ -0,0 +1,139 @@
+package routers
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"file-sharing/internal/lib/tuslib"
+	"file-sharing/internal/services"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newTestUploadRouter(t *testing.T) (*gin.Engine, *Router, *services.Upload) {
+	t.Helper()
+	router, r := newTestRouterWith(t)
+	us := services.NewUpload(r.dc, r.st, r.cfg)
+	r.RegisterUpload(router, us)
+	return router, r, us
+}
+
+func tus(router *gin.Engine, method, path string, body []byte, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	req.Header.Set("Tus-Resumable", tuslib.Version)
+	if method == http.MethodPatch {
+		req.Header.Set("Content-Type", tuslib.OffsetType)
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// createUpload creates an upload session of length bytes and returns its location.
+func createUpload(t *testing.T, router *gin.Engine, length int) string {
+	t.Helper()
+	w := tus(router, http.MethodPost, "/uploads", nil, map[string]string{
+		"Upload-Length":   strconv.Itoa(length),
+		"Upload-Metadata": "filename " + base64.StdEncoding.EncodeToString([]byte("a.bin")),
+	})
+	if w.Code != http.StatusCreated || w.Header().Get("Location") == "" {
+		t.Fatalf("create: status %d, body %s", w.Code, w.Body)
+	}
+	return w.Header().Get("Location")
+}
+
+func offsetOf(t *testing.T, router *gin.Engine, location string) string {
+	t.Helper()
+	w := tus(router, http.MethodHead, location, nil, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("head: status %d", w.Code)
+	}
+	return w.Header().Get("Upload-Offset")
+}
+
+func TestUploadResume(t *testing.T) {
+	router, _, _ := newTestUploadRouter(t)
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	location := createUpload(t, router, len(content))
+
+	if offset := offsetOf(t, router, location); offset != "0" {
+		t.Fatalf("offset of new upload: got %q, want 0", offset)
+	}
+
+	w := tus(router, http.MethodPatch, location, content[:4000], map[string]string{"Upload-Offset": "0"})
+	if w.Code != http.StatusNoContent || w.Header().Get("Upload-Offset") != "4000" {
+		t.Fatalf("first chunk: status %d, offset %q", w.Code, w.Header().Get("Upload-Offset"))
+	}
+	if offset := offsetOf(t, router, location); offset != "4000" {
+		t.Fatalf("offset after first chunk: got %q, want 4000", offset)
+	}
+
+	// Chunks not starting at the current offset are refused without changing it
+	for _, offset := range []string{"0", "3999", "4001", "10000"} {
+		w := tus(router, http.MethodPatch, location, content[4000:], map[string]string{"Upload-Offset": offset})
+		if w.Code != http.StatusConflict {
+			t.Fatalf("chunk at offset %v: status %d, want 409", offset, w.Code)
+		}
+	}
+	if offset := offsetOf(t, router, location); offset != "4000" {
+		t.Fatalf("offset after refused chunks: got %q, want 4000", offset)
+	}
+
+	w = tus(router, http.MethodPatch, location, content[4000:], map[string]string{"Upload-Offset": "4000"})
+	token := w.Header().Get("File-Token")
+	if w.Code != http.StatusNoContent || token == "" {
+		t.Fatalf("last chunk: status %d, file token %q, body %s", w.Code, token, w.Body)
+	}
+
+	// Finished session is gone, its content is shared as a file
+	if w := tus(router, http.MethodHead, location, nil, nil); w.Code != http.StatusNotFound {
+		t.Fatalf("head of finished upload: status %d, want 404", w.Code)
+	}
+	if w := download(router, token, nil); w.Code != http.StatusOK || !bytes.Equal(w.Body.Bytes(), content) {
+		t.Fatalf("download of finished upload: status %d, %d bytes", w.Code, w.Body.Len())
+	}
+}
+
+func TestUploadDeleteAbandoned(t *testing.T) {
+	router, r, us := newTestUploadRouter(t)
+	ctx := context.Background()
+	idle := createUpload(t, router, 10)
+	active := createUpload(t, router, 10)
+	tus(router, http.MethodPatch, idle, []byte("01234"), map[string]string{"Upload-Offset": "0"})
+
+	old := time.Now().Add(-time.Duration(r.cfg.UploadExpiry+1) * time.Hour)
+	id := idle[len("/uploads/"):]
+	if err := r.dc.Upload.UpdateOneID(id).SetUpdatedAt(old).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err := us.DeleteAbandoned(ctx)
+	if err != nil || len(deleted) != 1 || deleted[0].ID != id {
+		t.Fatalf("delete abandoned: got %d uploads, %v", len(deleted), err)
+	}
+	if w := tus(router, http.MethodHead, idle, nil, nil); w.Code != http.StatusNotFound {
+		t.Fatalf("head of abandoned upload: status %d, want 404", w.Code)
+	}
+	if _, err := os.Stat(filepath.Join(r.cfg.PartialPath(), id)); !os.IsNotExist(err) {
+		t.Fatalf("partial data of abandoned upload: got %v, want removed", err)
+	}
+
+	// Active session is kept and can still be finished
+	w := tus(router, http.MethodPatch, active, []byte("0123456789"), map[string]string{"Upload-Offset": "0"})
+	if w.Code != http.StatusNoContent || w.Header().Get("File-Token") == "" {
+		t.Fatalf("finish active upload: status %d, body %s", w.Code, w.Body)
+	}
+	if w := download(router, w.Header().Get("File-Token"), nil); w.Code != http.StatusOK {
+		t.Fatalf("download of active upload: status %d", w.Code)
+	}
+}
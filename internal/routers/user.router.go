@@ -0,0 +1,35 @@
+package routers
+
+import (
+	"file-sharing/internal/handlers"
+	"file-sharing/internal/middlewares"
+	"file-sharing/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UseAuth authenticates API keys of every request, it must be used before registering other routes.
+func (r *Router) UseAuth(router *gin.Engine) {
+	us := services.NewUser(r.dc, r.cfg)
+	router.Use(middlewares.Authenticate(us))
+}
+
+func (r *Router) RegisterUser(router *gin.Engine) {
+	us := services.NewUser(r.dc, r.cfg)
+	uh := handlers.NewUser(us)
+
+	g := router.Group("/users")
+
+	g.POST("/register", uh.Register)
+	g.POST("/login", uh.Login)
+
+	me := g.Group("/me", middlewares.RequireUser())
+
+	me.GET("", uh.GetMe)
+	me.GET("/keys", uh.GetKeys)
+	me.POST("/keys", uh.CreateKey)
+	me.DELETE("/keys/:id", uh.DeleteKey)
+	me.GET("/usage", uh.GetUsage)
+
+	g.PUT("/:username/plan", middlewares.RequireUser(), middlewares.RequireAdmin(r.cfg), uh.SetPlan)
+}
@@ -0,0 +1,18 @@
+package routers
+
+import (
+	"file-sharing/internal/handlers"
+	"file-sharing/internal/middlewares"
+	"file-sharing/internal/web"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (r *Router) RegisterWeb(router *gin.Engine) {
+	fs := web.FS()
+	wh := handlers.NewWeb(fs)
+
+	g := router.Group("/web", middlewares.StaticPage())
+	g.GET("/share/:token", wh.Share)
+	g.StaticFS("/assets", fs)
+}
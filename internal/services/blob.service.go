@@ -0,0 +1,390 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"file-sharing/config"
+	"file-sharing/ent"
+	"file-sharing/ent/file"
+	"file-sharing/internal/lib/crypto"
+	"file-sharing/internal/lib/filelib"
+	"file-sharing/internal/storage"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log"
+	"os"
+	"sync"
+)
+
+// Striped locks serializing reference counting of the same blob across services
+var blobLocks [64]sync.Mutex
+
+// Suffix of encrypted content written beside its plaintext while encrypting stored content
+const encryptingSuffix = ".encrypting"
+
+// Blob manages content-addressed blobs, a stored blob is removed when its last reference is gone.
+// Content is encrypted at rest with a data key per blob when master keys are configured.
+type Blob struct {
+	dc  *ent.Client
+	st  storage.Storage
+	kr  *crypto.Keyring // Nil if encryption is disabled
+	cfg *config.Config
+}
+
+// Content is stored content of a file, encrypted if DataKey or PasswordKey is set.
+type Content struct {
+	Key         string  // Storage key
+	Size        int64   // Size of plaintext
+	DataKey     *string // Data key wrapped by master key
+	PasswordKey *string // Data key wrapped by share password
+}
+
+// INIT
+
+func NewBlob(client *ent.Client, st storage.Storage, cfg *config.Config) *Blob {
+	// Keys are validated along with config
+	kr, _ := crypto.ParseKeyring(cfg.EncryptionKeys)
+	return &Blob{dc: client, st: st, kr: kr, cfg: cfg}
+}
+
+// PRIVATE UTIL
+
+func lockBlob(id string) func() {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	m := &blobLocks[h.Sum32()%uint32(len(blobLocks))]
+	m.Lock()
+	return m.Unlock
+}
+
+// store saves temporary file tmp as key, returning wrapped data key if it is encrypted.
+func (s *Blob) store(ctx context.Context, tmp, key string, size int64) (*string, error) {
+	f, err := os.Open(tmp)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return s.put(ctx, key, f, size)
+}
+
+// put saves size bytes of plaintext from r as key, encrypted with a new data key if encryption is enabled.
+func (s *Blob) put(ctx context.Context, key string, r io.Reader, size int64) (*string, error) {
+	if s.kr == nil {
+		return nil, s.st.Put(ctx, key, r, size)
+	}
+
+	dataKey := crypto.NewDataKey()
+	if err := s.putEncrypted(ctx, key, r, size, dataKey); err != nil {
+		return nil, err
+	}
+
+	wrapped := s.kr.Wrap(dataKey)
+	return &wrapped, nil
+}
+
+func (s *Blob) putEncrypted(ctx context.Context, key string, r io.Reader, size int64, dataKey []byte) error {
+	enc, err := crypto.NewEncrypter(r, dataKey, size)
+	if err != nil {
+		return err
+	}
+	return s.st.Put(ctx, key, enc, crypto.EncryptedSize(size))
+}
+
+// migrate encrypts plaintext content of key or wraps its data key again with current master key, saving new data key with save.
+// Encrypted content is written beside the plaintext and only moved over it once its data key is saved,
+// so an interrupted run is finished by the next one.
+func (s *Blob) migrate(ctx context.Context, key string, size int64, dataKey *string, save func(dataKey string) error) (encrypted, rewrapped bool, err error) {
+	tmp := key + encryptingSuffix
+
+	if dataKey != nil {
+		if _, err := s.st.Stat(ctx, tmp); err == nil {
+			if err := storage.Move(ctx, s.st, tmp, key); err != nil {
+				return false, false, err
+			}
+		}
+		if s.kr.IsCurrent(*dataKey) {
+			return false, false, nil
+		}
+
+		plain, err := s.kr.Unwrap(*dataKey)
+		if err != nil {
+			return false, false, err
+		}
+		return false, true, save(s.kr.Wrap(plain))
+	}
+
+	// Content may be cleared by cli already
+	if _, err := s.st.Stat(ctx, key); errors.Is(err, storage.ErrNotExist) {
+		return false, false, nil
+	}
+	r, err := s.st.Open(ctx, key, 0, -1)
+	if err != nil {
+		return false, false, err
+	}
+	defer r.Close()
+
+	wrapped, err := s.put(ctx, tmp, r, size)
+	if err != nil {
+		return false, false, err
+	}
+	if err := save(*wrapped); err != nil {
+		s.st.Delete(ctx, tmp)
+		return false, false, err
+	}
+	return true, false, storage.Move(ctx, s.st, tmp, key)
+}
+
+// release drops a reference of blob id in tx, returning key of content to delete when the last reference is gone.
+func (s *Blob) release(ctx context.Context, tx *ent.Tx, id string) (string, error) {
+	if err := tx.Blob.UpdateOneID(id).AddRefCount(-1).Exec(ctx); err != nil {
+		// Blob may be cleared by cli already
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	b, err := tx.Blob.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if b.RefCount > 0 {
+		return "", nil
+	}
+	if err := tx.Blob.DeleteOne(b).Exec(ctx); err != nil {
+		return "", err
+	}
+	return filelib.GetBlobKey(s.cfg, b.ID, b.Size), nil
+}
+
+func (s *Blob) unwrap(dataKey string) ([]byte, error) {
+	if s.kr == nil {
+		return nil, errors.New("content is encrypted but no encryption key is configured")
+	}
+	return s.kr.Unwrap(dataKey)
+}
+
+// SERVICES
+
+// Put stores temporary file tmp as blob hash and creates the file referencing it with create in the same transaction.
+// If the content is stored already, the stored blob gains a reference instead. Tmp is removed once the file is created,
+// on failure it is left to the caller, e.g. to retry finishing a resumable upload.
+func (s *Blob) Put(ctx context.Context, tmp, hash string, size int64, create func(tx *ent.Tx, blobID string) (*ent.File, error)) (*ent.File, error) {
+	unlock := lockBlob(hash)
+	defer unlock()
+
+	existing, err := s.dc.Blob.Get(ctx, hash)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	// Store content when the blob is new or its content went missing (e.g. cleared by cli).
+	// Content without a blob is stored again too, as its data key is unknown.
+	key := filelib.GetBlobKey(s.cfg, hash, size)
+	stored := false
+	var dataKey *string
+	if _, err := s.st.Stat(ctx, key); err != nil || existing == nil {
+		if err != nil && !errors.Is(err, storage.ErrNotExist) {
+			return nil, err
+		}
+		if dataKey, err = s.store(ctx, tmp, key, size); err != nil {
+			return nil, err
+		}
+		stored = true
+	}
+	fail := func(err error) (*ent.File, error) {
+		if stored {
+			s.st.Delete(context.Background(), key)
+		}
+		return nil, err
+	}
+
+	tx, err := s.dc.Tx(ctx)
+	if err != nil {
+		return fail(err)
+	}
+	rollback := func(err error) (*ent.File, error) {
+		tx.Rollback()
+		return fail(err)
+	}
+
+	// Add reference to existing blob, otherwise create it
+	if existing != nil {
+		q := tx.Blob.UpdateOne(existing).AddRefCount(1)
+		if stored && dataKey != nil {
+			q.SetDataKey(*dataKey)
+		} else if stored {
+			q.ClearDataKey()
+		}
+		err = q.Exec(ctx)
+	} else {
+		err = tx.Blob.Create().SetID(hash).SetSize(size).SetRefCount(1).SetNillableDataKey(dataKey).Exec(ctx)
+	}
+	if err != nil {
+		return rollback(err)
+	}
+
+	file, err := create(tx, hash)
+	if err != nil {
+		return rollback(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fail(err)
+	}
+	os.Remove(tmp)
+	return file, nil
+}
+
+// PutWithPassword stores temporary file tmp as key encrypted with a new data key, returning the data key wrapped by password.
+// Such content is never shared with other files, as nobody else could decrypt it.
+func (s *Blob) PutWithPassword(ctx context.Context, tmp, key string, size int64, password string) (string, error) {
+	defer os.Remove(tmp)
+
+	f, err := os.Open(tmp)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	dataKey := crypto.NewDataKey()
+	if err := s.putEncrypted(ctx, key, f, size, dataKey); err != nil {
+		return "", err
+	}
+	return crypto.WrapWithPassword(dataKey, password), nil
+}
+
+// RewrapPassword wraps data key of passwordKey, currently wrapped by old password, with new password instead.
+func (s *Blob) RewrapPassword(passwordKey, old, new string) (string, error) {
+	dataKey, err := crypto.UnwrapWithPassword(passwordKey, old)
+	if err != nil {
+		return "", err
+	}
+	return crypto.WrapWithPassword(dataKey, new), nil
+}
+
+// EncryptStored encrypts content stored in plaintext and wraps data keys of other master keys again with the current one.
+// It must run while the server is stopped, as content is replaced in storage.
+func (s *Blob) EncryptStored(ctx context.Context) (encrypted, rewrapped int, err error) {
+	if s.kr == nil {
+		return 0, 0, errors.New("no encryption key is configured")
+	}
+	count := func(e, r bool) {
+		if e {
+			encrypted++
+		}
+		if r {
+			rewrapped++
+		}
+	}
+
+	blobs, err := s.dc.Blob.Query().All(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, b := range blobs {
+		e, r, err := s.migrate(ctx, filelib.GetBlobKey(s.cfg, b.ID, b.Size), b.Size, b.DataKey, func(dataKey string) error {
+			return s.dc.Blob.UpdateOne(b).SetDataKey(dataKey).Exec(ctx)
+		})
+		if err != nil {
+			return encrypted, rewrapped, fmt.Errorf("blob %v: %w", b.ID, err)
+		}
+		count(e, r)
+	}
+
+	// Files stored before content-addressed storage keep their own data key
+	// Content encrypted by password is left alone, it is encrypted already
+	files, err := s.dc.File.Query().Where(file.BlobIDIsNil(), file.PasswordKeyIsNil()).All(ctx)
+	if err != nil {
+		return encrypted, rewrapped, err
+	}
+	for _, f := range files {
+		e, r, err := s.migrate(ctx, filelib.GetKey(s.cfg, f), f.FileSize, f.DataKey, func(dataKey string) error {
+			return s.dc.File.UpdateOne(f).SetDataKey(dataKey).Exec(ctx)
+		})
+		if err != nil {
+			return encrypted, rewrapped, fmt.Errorf("file %v: %w", f.ID, err)
+		}
+		count(e, r)
+	}
+
+	return encrypted, rewrapped, nil
+}
+
+// GetContent locates stored content of file.
+func (s *Blob) GetContent(ctx context.Context, file *ent.File) (*Content, error) {
+	c := &Content{Key: filelib.GetKey(s.cfg, file), Size: file.FileSize, DataKey: file.DataKey, PasswordKey: file.PasswordKey}
+	if file.BlobID != nil {
+		b, err := s.dc.Blob.Get(ctx, *file.BlobID)
+		if err != nil {
+			return nil, err
+		}
+		c.DataKey = b.DataKey
+	}
+	return c, nil
+}
+
+// Open reads plaintext of content lazily, opening it again after seeking, so ranges are fetched on their own.
+// Password is only used by content encrypted by password.
+func (s *Blob) Open(ctx context.Context, c *Content, password string) (io.ReadSeekCloser, error) {
+	var dataKey []byte
+	var err error
+	switch {
+	case c.PasswordKey != nil:
+		dataKey, err = crypto.UnwrapWithPassword(*c.PasswordKey, password)
+	case c.DataKey != nil:
+		dataKey, err = s.unwrap(*c.DataKey)
+	default:
+		return storage.NewReadSeeker(ctx, s.st, c.Key, c.Size), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return crypto.NewDecrypter(func(offset int64) (io.ReadCloser, error) {
+		return s.st.Open(ctx, c.Key, offset, -1)
+	}, dataKey, c.Size)
+}
+
+// Remove deletes row of f and drops its reference to its blob in one transaction, so a file deleted concurrently is released once.
+// Stored content is deleted after commit once nothing references it, failing that is only logged as the file is gone already.
+// Returns how many rows were deleted.
+func (s *Blob) Remove(ctx context.Context, f *ent.File) (int, error) {
+	if f.BlobID != nil {
+		// Held until content is deleted, so no upload can reference the blob before it is gone
+		unlock := lockBlob(*f.BlobID)
+		defer unlock()
+	}
+
+	tx, err := s.dc.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := tx.File.Delete().Where(file.ID(f.ID)).Exec(ctx)
+	if err != nil || n == 0 {
+		tx.Rollback()
+		return 0, err
+	}
+
+	// Files stored before content-addressed storage own their content
+	key := filelib.GetKey(s.cfg, f)
+	if f.BlobID != nil {
+		key, err = s.release(ctx, tx, *f.BlobID)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	if key != "" {
+		if err := s.st.Delete(ctx, key); err != nil {
+			log.Printf("Error removing content %v of deleted file %v:\n%v", key, f.Token, err)
+		}
+	}
+	return n, nil
+}
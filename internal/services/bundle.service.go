@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"file-sharing/config"
+	"file-sharing/ent"
+	"file-sharing/ent/bundle"
+	"file-sharing/ent/file"
+	"file-sharing/ent/predicate"
+	"file-sharing/internal/lib/archivelib"
+	"file-sharing/internal/lib/authlib"
+	"file-sharing/internal/lib/crypto"
+	"file-sharing/internal/lib/filelib"
+	"file-sharing/internal/lib/reply"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+)
+
+// Bundle shares many files uploaded together under one token, every member is still a file with its own token.
+type Bundle struct {
+	dc  *ent.Client
+	fs  *File
+	cfg *config.Config
+}
+
+// BundleFiles is a bundle along with its member files.
+type BundleFiles struct {
+	*ent.Bundle
+	Files []*ent.File `json:"files"`
+}
+
+// UploadedBundle is a freshly uploaded bundle along with its plaintext management token, which is only shown once.
+// The token manages the bundle and each of its members.
+type UploadedBundle struct {
+	BundleFiles
+	ManageToken string `json:"manage_token"`
+}
+
+type AttachedGinBundle struct {
+	dc  *ent.Client
+	fs  *AttachedGinFile
+	cfg *config.Config
+	c   *gin.Context
+	ctx context.Context
+}
+
+// INIT
+
+func NewBundle(client *ent.Client, fs *File, cfg *config.Config) *Bundle {
+	return &Bundle{dc: client, fs: fs, cfg: cfg}
+}
+
+func (s *Bundle) AttachGin(c *gin.Context) *AttachedGinBundle {
+	return &AttachedGinBundle{s.dc, s.fs.AttachGin(c), s.cfg, c, c.Request.Context()}
+}
+
+// PRIVATE UTIL
+
+func (s *AttachedGinBundle) replyDbError(err error) {
+	rp := reply.New(s.c)
+
+	if errors.Is(err, ErrExpired) {
+		rp.Error(reply.CodeExpired, "Bundle sharing was expired").Fail()
+		return
+	}
+	if ent.IsNotFound(err) {
+		rp.Error(reply.CodeNotFound, "Bundle not found. This could be happen because bundle sharing was expired").Fail()
+		return
+	}
+	rp.Error(reply.CodeBadGateWay, err.Error()).Fail()
+}
+
+// hasBundleDownloadSlot compares download_count against max_downloads column in database.
+var hasBundleDownloadSlot = predicate.Bundle(sql.FieldsLT(bundle.FieldDownloadCount, bundle.FieldMaxDownloads))
+
+// removeFiles removes rows and content of files, returning the first error after trying every file.
+func (s *AttachedGinBundle) removeFiles(files []*ent.File) error {
+	var first error
+	for _, f := range files {
+		if _, err := s.fs.bs.Remove(s.ctx, f); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// SERVICES
+
+// DeleteExpired removes expired bundles from database, their members expire along and are removed as files.
+func (s *Bundle) DeleteExpired(ctx context.Context) (int, error) {
+	return s.dc.Bundle.Delete().Where(bundle.ExpiresAtLTE(time.Now())).Exec(ctx)
+}
+
+// ProcessUpload stores files as members of a new bundle, share settings from form apply to the bundle and each member.
+func (s *AttachedGinBundle) ProcessUpload(files []*multipart.FileHeader, allowReply bool) (*UploadedBundle, error) {
+	rp := reply.New(s.c)
+
+	// Get and validate optional parameters from form
+	opt, err := ParseUploadOptions(s.cfg, s.c.Request.FormValue)
+	if err == nil && (opt.ExpectedSHA256 != "" || opt.ExpectedMD5 != "") {
+		err = errors.New("Expected checksum can only be used when uploading a single file")
+	}
+	if err == nil && opt.encryptedBy(file.EncryptionClient) {
+		err = errors.New("Client-side encryption can only be used when uploading a single file")
+	}
+	if err != nil {
+		if allowReply {
+			rp.Error(reply.CodeBadRequest, err.Error()).Fail()
+		}
+		return nil, err
+	}
+
+	// Whole bundle must fit in quota, so no member is stored for nothing.
+	// Held until every member is saved, so concurrent uploads are checked against them
+	unlock := s.fs.qs.Lock(authlib.GetUser(s.c))
+	defer unlock()
+	if err := s.fs.checkFilesQuota(files, allowReply); err != nil {
+		return nil, err
+	}
+
+	// Management token is stored hashed, members share it with the bundle
+	manageToken := crypto.CreateSecret(s.cfg.SecretLength)
+	manageHash := crypto.HashToken(manageToken)
+
+	q := s.dc.Bundle.Create().
+		SetToken(crypto.CreateToken(s.cfg.TokenLength)).
+		SetManageToken(manageHash).
+		SetNillableOwnerID(authlib.GetUserID(s.c)).
+		SetNillableMaxDownloads(opt.MaxDownloads).
+		SetNillableExpiresAt(opt.ExpiresAt)
+	if opt.Password != "" {
+		q.SetPassword(opt.hashPassword())
+	}
+	b, err := q.Save(s.ctx)
+	if err != nil {
+		if allowReply {
+			rp.Error(reply.CodeServerError, "Error while saving bundle metadata", err.Error()).Fail()
+		}
+		return nil, err
+	}
+
+	// Members expire along with the bundle
+	opt.ExpiresAt = &b.ExpiresAt
+
+	members := []*ent.File{}
+	for _, u := range files {
+		f, err := s.fs.store(u, opt, manageHash, &b.ID, allowReply)
+		if err != nil {
+			// Rollback: remove stored members and the bundle
+			s.removeFiles(members)
+			s.dc.Bundle.DeleteOne(b).Exec(s.ctx)
+			return nil, err
+		}
+		members = append(members, f)
+	}
+
+	return &UploadedBundle{BundleFiles{b, members}, manageToken}, nil
+}
+
+func (s *AttachedGinBundle) GetOne(token string, allowReply bool) (*ent.Bundle, error) {
+	b, err := s.dc.Bundle.Query().Where(bundle.Token(token)).First(s.ctx)
+
+	// Treat expired bundles as gone even before the reaper deletes them
+	if err == nil && filelib.IsBundleExpired(b) {
+		b, err = nil, ErrExpired
+	}
+
+	if allowReply && err != nil {
+		s.replyDbError(err)
+		return nil, err
+	}
+
+	return b, err
+}
+
+// GetFiles returns members of b which are not deleted or expired, in upload order.
+func (s *AttachedGinBundle) GetFiles(b *ent.Bundle, allowReply bool) ([]*ent.File, error) {
+	files, err := s.dc.File.Query().
+		Where(file.BundleID(b.ID), file.ExpiresAtGT(time.Now())).
+		Order(ent.Asc(file.FieldCreatedAt)).
+		All(s.ctx)
+
+	if allowReply && err != nil {
+		s.replyDbError(err)
+		return nil, err
+	}
+
+	return files, err
+}
+
+// DeleteOne removes b along with every member, writing members into a delete log in logPath.
+func (s *AttachedGinBundle) DeleteOne(b *ent.Bundle, logPath string, allowReply bool) error {
+	rp := reply.New(s.c)
+
+	files, err := s.dc.File.Query().Where(file.BundleID(b.ID)).All(s.ctx)
+	if err != nil {
+		if allowReply {
+			s.replyDbError(err)
+		}
+		return err
+	}
+
+	if err := s.fs.CreateDeleteLog(files, logPath); err != nil {
+		if allowReply {
+			rp.Error(reply.CodeServerError, err.Error()).Fail()
+		}
+		return err
+	}
+
+	if err := s.removeFiles(files); err != nil {
+		if allowReply {
+			rp.Error(reply.CodeServerError, "Error cannot remove bundle files", err.Error()).Fail()
+		}
+		return err
+	}
+
+	if err := s.dc.Bundle.DeleteOne(b).Exec(s.ctx); err != nil {
+		if allowReply {
+			s.replyDbError(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// CreateDownloadURL signs a short-lived URL downloading b without password, password must be checked by caller.
+func (s *AttachedGinBundle) CreateDownloadURL(b *ent.Bundle) *SignedURL {
+	return signDownloadURL(s.cfg, "/bundles/"+b.Token+"/download", b.ID, b.Password)
+}
+
+// CheckPassword verifies password of b, locking b out after too many wrong passwords, see checkLockout.
+func (s *AttachedGinBundle) CheckPassword(b *ent.Bundle, password string, allowReply bool) error {
+	if b.Password == nil {
+		return nil
+	}
+
+	err := checkLockout(s.ctx, s.cfg, b.FailedAttempts, b.LockedUntil,
+		func() bool { return filelib.IsBundlePasswordCorrect(b, password) },
+		func() *ent.BundleUpdateOne { return s.dc.Bundle.UpdateOne(b) },
+		func(b *ent.Bundle) int { return b.FailedAttempts },
+	)
+	if allowReply && err != nil && !replyLockout(s.c, err, b.LockedUntil, "bundle") {
+		s.replyDbError(err)
+	}
+	return err
+}
+
+// VerifyDownloadURL checks signature of download URL requested for b.
+func (s *AttachedGinBundle) VerifyDownloadURL(b *ent.Bundle, allowReply bool) error {
+	return verifyDownloadURL(s.c, s.cfg, b.ID, b.Password, allowReply)
+}
+
+// ClaimDownload atomically takes a download slot of b, concurrent claims never exceed max downloads.
+func (s *AttachedGinBundle) ClaimDownload(b *ent.Bundle) (*ent.Bundle, error) {
+	return claimDownload(s.ctx, s.dc,
+		func(tx *ent.Tx) (int, error) {
+			return tx.Bundle.Update().
+				Where(bundle.ID(b.ID), bundle.Or(bundle.MaxDownloadsIsNil(), hasBundleDownloadSlot)).
+				AddDownloadCount(1).
+				Save(s.ctx)
+		},
+		nil,
+		func(tx *ent.Tx) (*ent.Bundle, error) { return tx.Bundle.Get(s.ctx, b.ID) },
+	)
+}
+
+// SendToDownload streams files of b as an archive of format, claiming one download of the bundle.
+// Members are read one by one from storage, so nothing is buffered on disk or in memory.
+// Password decrypts members encrypted by password.
+func (s *AttachedGinBundle) SendToDownload(b *ent.Bundle, files []*ent.File, format, password string) error {
+	rp := reply.New(s.c)
+
+	// Make sure every member is readable before reply is started
+	entries := []archivelib.Entry{}
+	for _, f := range files {
+		content, err := s.fs.bs.GetContent(s.ctx, f)
+		if err == nil {
+			_, err = s.fs.st.Stat(s.ctx, content.Key)
+		}
+		if err != nil {
+			rp.Error(reply.CodeServerError, "Error cannot open file", f.FileName).Fail()
+			return err
+		}
+		entries = append(entries, archivelib.Entry{
+			Name:    f.FileName,
+			Size:    f.FileSize,
+			ModTime: f.CreatedAt,
+			Open: func() (io.ReadCloser, error) {
+				return s.fs.bs.Open(s.ctx, content, password)
+			},
+		})
+	}
+	archivelib.UniqueNames(entries)
+
+	claimed, err := s.ClaimDownload(b)
+	if errors.Is(err, ErrMaxDownloads) {
+		rp.Error(reply.CodeBadRequest, "Max download reached").Fail()
+		return err
+	}
+	if err != nil {
+		s.replyDbError(err)
+		return err
+	}
+	*b = *claimed
+
+	name := b.Token + "." + format
+	s.c.Header("Content-Type", archivelib.GetContentType(format))
+	s.c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+	s.c.Header("Cache-Control", "no-store")
+	s.c.Status(http.StatusOK)
+
+	// Reply is already started, so a failure can only cut the archive short
+	if err := archivelib.Write(s.c.Writer, format, entries); err != nil {
+		log.Printf("Error streaming bundle %v:\n%v", b.Token, err)
+		s.c.Abort()
+		return err
+	}
+	return nil
+}
@@ -11,7 +11,8 @@ import (
 )
 
 func Connect(path string, autoCreateSchema bool) *ent.Client {
-	client, err := ent.Open(dialect.SQLite, fmt.Sprintf("file:%v?cache=shared&_fk=1", path))
+	// WAL and busy timeout let concurrent writers wait for each other instead of failing with locked table
+	client, err := ent.Open(dialect.SQLite, fmt.Sprintf("file:%v?_fk=1&_journal_mode=WAL&_busy_timeout=5000", path))
 	if err != nil {
 		log.Fatalf("failed opening connection to sqlite: %v", err)
 	}
@@ -2,46 +2,452 @@ package services
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"file-sharing/config"
 	"file-sharing/ent"
 	"file-sharing/ent/file"
+	"file-sharing/ent/predicate"
+	"file-sharing/internal/lib/authlib"
 	"file-sharing/internal/lib/crypto"
 	"file-sharing/internal/lib/filelib"
+	"file-sharing/internal/lib/httplib"
+	"file-sharing/internal/lib/pagelib"
 	"file-sharing/internal/lib/reply"
+	"file-sharing/internal/lib/timelib"
+	"file-sharing/internal/storage"
 	"fmt"
+	"log"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
 )
 
+// Claim of a counted download, sent back by client to resume it with ranges
+const (
+	claimHeader = "X-Download-Claim"
+	claimCookie = "download_claim"
+)
+
+var (
+	ErrExpired      = errors.New("file expired")
+	ErrMaxDownloads = errors.New("max downloads reached")
+	ErrWrongPass    = errors.New("wrong password")
+	ErrLocked       = errors.New("file locked after too many wrong passwords")
+	ErrMimeDenied   = errors.New("type of content is not allowed")
+)
+
+// Scopes of file listing
+const (
+	ScopePublic = "public" // Public files of everyone
+	ScopeMine   = "mine"   // Every file of authenticated user
+	ScopeAll    = "all"    // Every file, admin only
+)
+
+// Allowance for multipart boundaries and headers when comparing request length to quota
+const formOverhead = 64 * config.KB
+
+// Metadata of client-encrypted files is opaque, so only its size is limited
+const maxMetadata = 4 * config.KB
+
+// Stored name and MIME type of client-encrypted files, real ones are in their encrypted metadata
+const (
+	clientEncryptedName = "encrypted"
+	clientEncryptedMime = "application/octet-stream"
+)
+
+// Sort fields of file listing by sort query value
+var fileSortFields = map[string]string{
+	"created": file.FieldCreatedAt,
+	"expires": file.FieldExpiresAt,
+	"size":    file.FieldFileSize,
+	"name":    file.FieldFileName,
+	"mime":    file.FieldMime,
+}
+
 type File struct {
-	dc *ent.Client
+	dc  *ent.Client
+	st  storage.Storage
+	bs  *Blob
+	qs  *Quota
+	cfg *config.Config
+}
+
+// UploadedFile is a freshly uploaded file along with its plaintext management token, which is only shown once.
+type UploadedFile struct {
+	*ent.File
+	ManageToken string `json:"manage_token"`
+}
+
+// FileEdit holds editable metadata of a file, nil and false fields are left unchanged.
+type FileEdit struct {
+	FileName           *string `form:"file-name" json:"file-name"`
+	Password           *string `form:"password" json:"password"`
+	CurrentPassword    string  `form:"current-password" json:"current-password"` // Required to change password of a file encrypted by password
+	RemovePassword     bool    `form:"remove-password" json:"remove-password"`
+	MaxDownloads       *int    `form:"max-downloads" json:"max-downloads"`
+	RemoveMaxDownloads bool    `form:"remove-max-downloads" json:"remove-max-downloads"`
+	ResetDownloadCount bool    `form:"reset-download-count" json:"reset-download-count"`
+	ExpiresIn          string  `form:"expires-in" json:"expires-in"`
+	ExpiresAt          string  `form:"expires-at" json:"expires-at"`
+	Visibility         *string `form:"visibility" json:"visibility"`
+}
+
+// UploadOptions holds optional share settings given on upload.
+type UploadOptions struct {
+	Password       string
+	passwordHash   string
+	MaxDownloads   *int
+	ExpiresAt      *time.Time
+	Visibility     *file.Visibility
+	Encryption     *file.Encryption // Nil if content is only encrypted at rest
+	Metadata       string           // Base64url metadata of client-encrypted content
+	ExpectedSHA256 string           // Hex digest client expects, upload is rejected on mismatch
+	ExpectedMD5    string           // Hex digest client expects, upload is rejected on mismatch
+}
+
+// SignedURL is a download URL working without password until it expires.
+type SignedURL struct {
+	URL       string    `json:"url"` // Path with signature, relative to this server
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// FileQuery holds filters, sorting and paging of file listing.
+type FileQuery struct {
+	Scope   string
+	Filters []predicate.File
+	Sort    string // Key of fileSortFields
+	Order   string // "asc" or "desc"
+	Limit   int
+	Offset  int             // Deprecated by Cursor, kept for older clients
+	Cursor  *pagelib.Cursor // Nil on first page
 }
 
 type AttachedGinFile struct {
 	dc  *ent.Client
+	st  storage.Storage
+	bs  *Blob
+	qs  *Quota
+	cfg *config.Config
 	c   *gin.Context
 	ctx context.Context
 }
 
 // INIT
 
-func NewFile(client *ent.Client) *File {
-	return &File{dc: client}
+func NewFile(client *ent.Client, st storage.Storage, cfg *config.Config) *File {
+	return &File{dc: client, st: st, bs: NewBlob(client, st, cfg), qs: NewQuota(client, cfg), cfg: cfg}
 }
 
 func (s *File) AttachGin(c *gin.Context) *AttachedGinFile {
-	return &AttachedGinFile{s.dc, c, c.Request.Context()}
+	return &AttachedGinFile{s.dc, s.st, s.bs, s.qs, s.cfg, c, c.Request.Context()}
+}
+
+// UTIL
+
+// ParseUploadOptions reads optional share settings by upload form field names using get.
+func ParseUploadOptions(cfg *config.Config, get func(key string) string) (*UploadOptions, error) {
+	o := &UploadOptions{
+		Password:       get("password"),
+		ExpectedSHA256: strings.TrimSpace(get("expected-sha256")),
+		ExpectedMD5:    strings.TrimSpace(get("expected-md5")),
+	}
+
+	// Set max downloads limit if provided, a share which can't be downloaded is refused
+	if v := strings.TrimSpace(get("max-downloads")); v != "" {
+		md, err := strconv.Atoi(v)
+		if err != nil || md < 1 {
+			return nil, errors.New("Max downloads must be a number greater than 0")
+		}
+		o.MaxDownloads = &md
+	}
+
+	expiry, err := timelib.ParseExpiry(get("expires-in"), get("expires-at"), cfg.MaxExpiry)
+	if err != nil {
+		return nil, err
+	}
+	o.ExpiresAt = expiry
+
+	// Set visibility if provided, otherwise schema default (unlisted) is used
+	if v := get("visibility"); v != "" {
+		vis, err := ParseVisibility(v)
+		if err != nil {
+			return nil, err
+		}
+		o.Visibility = &vis
+	}
+
+	// Content encrypted by password is only readable by those knowing the password,
+	// content encrypted by client is stored as is and only readable by those given its key
+	if e := get("encryption"); e != "" {
+		enc, err := ParseEncryption(e)
+		if err != nil {
+			return nil, err
+		}
+		switch enc {
+		case file.EncryptionPassword:
+			if o.Password == "" {
+				return nil, errors.New("Password is required to encrypt content by password")
+			}
+		case file.EncryptionClient:
+			// Type of encrypted content can't be detected, so it would slip past allowed and denied types
+			if len(cfg.AllowedMimes) > 0 || len(cfg.DeniedMimes) > 0 {
+				return nil, errors.New("Client-side encryption is not allowed, as types of uploaded content are restricted")
+			}
+			o.Metadata = strings.TrimSpace(get("metadata"))
+			if _, err := base64.RawURLEncoding.DecodeString(o.Metadata); err != nil || o.Metadata == "" {
+				return nil, errors.New("Please add encrypted file name and MIME type in 'metadata' as unpadded base64url")
+			}
+			if len(o.Metadata) > maxMetadata {
+				return nil, fmt.Errorf("Metadata can not be longer than %v bytes", maxMetadata)
+			}
+		}
+		o.Encryption = &enc
+	}
+
+	return o, nil
+}
+
+// ParseFileQuery reads listing query parameters using get, filters are combined with AND.
+func ParseFileQuery(cfg *config.Config, get func(key string) string) (*FileQuery, error) {
+	q := &FileQuery{
+		Scope: get("scope"),
+		Sort:  get("sort"),
+		Order: get("order"),
+		Limit: cfg.PaginationLimit,
+	}
+
+	// Mime matches exactly, or by type with a wildcard like "image/*"
+	if m := strings.TrimSpace(get("mime")); m != "" {
+		if prefix, ok := strings.CutSuffix(m, "*"); ok {
+			q.Filters = append(q.Filters, file.MimeHasPrefix(prefix))
+		} else {
+			q.Filters = append(q.Filters, file.MimeEqualFold(m))
+		}
+	}
+	if name := strings.TrimSpace(get("name")); name != "" {
+		q.Filters = append(q.Filters, file.FileNameContainsFold(name))
+	}
+
+	for key, where := range map[string]func(int64) predicate.File{
+		"min-size": file.FileSizeGTE,
+		"max-size": file.FileSizeLTE,
+	} {
+		if v := get(key); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("%v must be a number of bytes", key)
+			}
+			q.Filters = append(q.Filters, where(n))
+		}
+	}
+
+	for key, where := range map[string]func(time.Time) predicate.File{
+		"created-after":  file.CreatedAtGTE,
+		"created-before": file.CreatedAtLT,
+		"expires-after":  file.ExpiresAtGTE,
+		"expires-before": file.ExpiresAtLT,
+	} {
+		if v := get(key); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, fmt.Errorf("%v must be RFC 3339 time, e.g. 2006-01-02T15:04:05Z", key)
+			}
+			q.Filters = append(q.Filters, where(t))
+		}
+	}
+
+	if v := get("protected"); v != "" {
+		protected, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.New("protected must be 'true' or 'false'")
+		}
+		if protected {
+			q.Filters = append(q.Filters, file.PasswordNotNil())
+		} else {
+			q.Filters = append(q.Filters, file.PasswordIsNil())
+		}
+	}
+
+	// Newest files come first by default
+	if q.Sort == "" {
+		q.Sort = "created"
+	}
+	if _, ok := fileSortFields[q.Sort]; !ok {
+		return nil, errors.New("Please sort by 'created', 'expires', 'size', 'name' or 'mime'")
+	}
+	if q.Order == "" {
+		q.Order = "desc"
+	}
+	if q.Order != "asc" && q.Order != "desc" {
+		return nil, errors.New("Please use order 'asc' or 'desc'")
+	}
+
+	if v := get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > cfg.MaxPageLimit {
+			return nil, fmt.Errorf("limit must be a number between 1 and %v", cfg.MaxPageLimit)
+		}
+		q.Limit = n
+	}
+
+	if v := get("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return nil, errors.New("offset must be a positive number")
+		}
+		q.Offset = n
+	}
+
+	if v := get("cursor"); v != "" {
+		c, err := pagelib.Decode(v)
+		if err != nil {
+			return nil, err
+		}
+		// Cursor only makes sense in the sorting it was made in
+		if c.Sort != q.Sort || c.Order != q.Order || q.Offset != 0 {
+			return nil, pagelib.ErrInvalidCursor
+		}
+		if c.Value, err = fileSortValue(c.Sort, c.Value); err != nil {
+			return nil, err
+		}
+		q.Cursor = c
+	}
+
+	return q, nil
+}
+
+// fileSortValue converts cursor value v decoded from JSON back into the type of sort field.
+func fileSortValue(sort string, v any) (any, error) {
+	switch sort {
+	case "created", "expires":
+		if s, ok := v.(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				// Stored times are local, compare in the same zone
+				return t.Local(), nil
+			}
+		}
+	case "size":
+		if n, ok := v.(json.Number); ok {
+			if size, err := n.Int64(); err == nil {
+				return size, nil
+			}
+		}
+	default:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	}
+	return nil, pagelib.ErrInvalidCursor
+}
+
+// nextCursor returns cursor pointing after f in sorting of q.
+func (q *FileQuery) nextCursor(f *ent.File) string {
+	c := &pagelib.Cursor{Sort: q.Sort, Order: q.Order, ID: f.ID}
+	switch q.Sort {
+	case "created":
+		c.Value = f.CreatedAt
+	case "expires":
+		c.Value = f.ExpiresAt
+	case "size":
+		c.Value = f.FileSize
+	case "name":
+		c.Value = f.FileName
+	case "mime":
+		c.Value = f.Mime
+	}
+	return c.Encode()
+}
+
+// after selects files following cursor of q, id breaks ties of equal sort values.
+func (q *FileQuery) after() predicate.File {
+	field := fileSortFields[q.Sort]
+	cmp := sql.FieldGT
+	if q.Order == "desc" {
+		cmp = sql.FieldLT
+	}
+	return file.Or(
+		cmp(field, q.Cursor.Value),
+		file.And(sql.FieldEQ(field, q.Cursor.Value), cmp(file.FieldID, q.Cursor.ID)),
+	)
+}
+
+func (q *FileQuery) order() []file.OrderOption {
+	by := sql.OrderAsc()
+	if q.Order == "desc" {
+		by = sql.OrderDesc()
+	}
+	return []file.OrderOption{sql.OrderByField(fileSortFields[q.Sort], by).ToFunc(), file.ByID(by)}
+}
+
+func ParseVisibility(v string) (file.Visibility, error) {
+	vis := file.Visibility(v)
+	if file.VisibilityValidator(vis) != nil {
+		return "", errors.New("Visibility must be 'public' or 'unlisted'")
+	}
+	return vis, nil
+}
+
+func ParseEncryption(v string) (file.Encryption, error) {
+	enc := file.Encryption(v)
+	if file.EncryptionValidator(enc) != nil {
+		return "", errors.New("Encryption must be 'password' or 'client'")
+	}
+	return enc, nil
+}
+
+// hashPassword hashes password once for every file sharing the options, empty if no password is provided.
+func (o *UploadOptions) hashPassword() string {
+	if o.Password != "" && o.passwordHash == "" {
+		o.passwordHash = crypto.HashPassword(o.Password)
+	}
+	return o.passwordHash
+}
+
+func (o *UploadOptions) encryptedBy(enc file.Encryption) bool {
+	return o.Encryption != nil && *o.Encryption == enc
+}
+
+func (o *UploadOptions) apply(q *ent.FileCreate) {
+	// Set optional password if provided
+	if o.Password != "" {
+		q.SetPassword(o.hashPassword())
+	}
+	if o.MaxDownloads != nil {
+		q.SetMaxDownloads(*o.MaxDownloads)
+	}
+	// Set expiry if provided, otherwise schema default is used
+	if o.ExpiresAt != nil {
+		q.SetExpiresAt(*o.ExpiresAt)
+	}
+	q.SetNillableVisibility(o.Visibility)
+	q.SetNillableEncryption(o.Encryption)
+	if o.Metadata != "" {
+		q.SetMetadata(o.Metadata)
+	}
 }
 
 // PRIVATE UTIL
 
+// hasDownloadSlot compares download_count against max_downloads column in database.
+var hasDownloadSlot = predicate.File(sql.FieldsLT(file.FieldDownloadCount, file.FieldMaxDownloads))
+
 func (s *AttachedGinFile) replyDbError(err error) {
 	rp := reply.New(s.c)
 
+	if errors.Is(err, ErrExpired) {
+		rp.Error(reply.CodeExpired, "File sharing was expired").Fail()
+		return
+	}
 	if ent.IsNotFound(err) {
 		rp.Error(reply.CodeNotFound, "File not found. This could be happen because file sharing was expired").Fail()
 		return
@@ -49,14 +455,281 @@ func (s *AttachedGinFile) replyDbError(err error) {
 	rp.Error(reply.CodeBadGateWay, err.Error()).Fail()
 }
 
+// detectMime detects type of content at path named name, replying unsupported type if it's not allowed by config.
+func detectMime(c *gin.Context, cfg *config.Config, path, name string, allowReply bool) (string, error) {
+	rp := reply.New(c)
+
+	detected, err := filelib.DetectMime(path, name)
+	if err != nil {
+		if allowReply {
+			rp.Error(reply.CodeServerError, "Error while detecting file type", err.Error()).Fail()
+		}
+		return "", err
+	}
+
+	mime := filelib.MimeEssence(detected)
+	if !filelib.IsMimeAllowed(cfg, detected) {
+		if allowReply {
+			rp.Error(reply.CodeUnsupported, fmt.Sprintf("Files of type %v are not allowed", mime), name).Fail()
+		}
+		return "", ErrMimeDenied
+	}
+	return mime, nil
+}
+
+// checkQuota makes sure uploading shares files of bytes in total keeps authenticated user within quota.
+func (s *AttachedGinFile) checkQuota(shares int, bytes, largest int64, allowReply bool) error {
+	err := s.qs.Check(s.ctx, authlib.GetUser(s.c), shares, bytes, largest)
+	if err == nil {
+		return nil
+	}
+
+	if allowReply {
+		var qe *QuotaError
+		if errors.As(err, &qe) {
+			qe.Reply(reply.New(s.c))
+		} else {
+			s.replyDbError(err)
+		}
+	}
+	return err
+}
+
+// checkFilesQuota checks quota of uploading every file of files.
+func (s *AttachedGinFile) checkFilesQuota(files []*multipart.FileHeader, allowReply bool) error {
+	var total, largest int64
+	for _, u := range files {
+		total += u.Size
+		largest = max(largest, u.Size)
+	}
+	return s.checkQuota(len(files), total, largest, allowReply)
+}
+
+func (s *AttachedGinFile) saveHashed(u *multipart.FileHeader, dst string) (*filelib.Checksum, int64, error) {
+	src, err := u.Open()
+	if err != nil {
+		return nil, 0, err
+	}
+	defer src.Close()
+
+	return filelib.WriteHashed(src, dst)
+}
+
+// store saves content of u into blob store and creates its file with share settings opt, as member of bundleID if given.
+func (s *AttachedGinFile) store(u *multipart.FileHeader, opt *UploadOptions, manageToken string, bundleID *string, allowReply bool) (*ent.File, error) {
+	rp := reply.New(s.c)
+
+	// Ensure temporary upload directory exists
+	if err := filelib.CreateDir(s.cfg); err != nil {
+		if allowReply {
+			rp.Error(reply.CodeServerError, "Error creating directories for upload file", err.Error()).Fail()
+		}
+		return nil, err
+	}
+
+	// Type is detected from content, type declared by client is only kept along
+	name := u.Filename
+	declared := u.Header.Get("Content-Type")
+	mime := ""
+	// Real name and MIME type of client-encrypted content are only in its metadata
+	clientEncrypted := opt.encryptedBy(file.EncryptionClient)
+	if clientEncrypted {
+		name, declared, mime = clientEncryptedName, "", clientEncryptedMime
+	}
+
+	// Save physical file to temporary path while hashing its content
+	tmp := filelib.GetTempPathname(s.cfg)
+	sum, size, err := s.saveHashed(u, tmp)
+	if err != nil {
+		os.Remove(tmp)
+		if allowReply {
+			rp.Error(reply.CodeServerError, "Error while saving file to disk", err.Error()).Fail()
+		}
+		return nil, err
+	}
+
+	// Reject content not matching checksum expected by client
+	if err := sum.Verify(opt.ExpectedSHA256, opt.ExpectedMD5); err != nil {
+		os.Remove(tmp)
+		if allowReply {
+			rp.Error(reply.CodeBadRequest, err.Error()).Fail()
+		}
+		return nil, err
+	}
+
+	if !clientEncrypted {
+		if mime, err = detectMime(s.c, s.cfg, tmp, name, allowReply); err != nil {
+			os.Remove(tmp)
+			return nil, err
+		}
+	}
+
+	token := crypto.CreateToken(s.cfg.TokenLength)
+	create := func(q *ent.FileCreate) *ent.FileCreate {
+		q.SetToken(token).
+			SetFileName(name).
+			SetFileSize(size).
+			SetMime(mime).
+			SetManageToken(manageToken).
+			SetNillableBundleID(bundleID).
+			SetNillableOwnerID(authlib.GetUserID(s.c))
+		if declared != "" {
+			q.SetDeclaredMime(declared)
+		}
+		opt.apply(q)
+		return q
+	}
+
+	byPassword := opt.encryptedBy(file.EncryptionPassword)
+	var file *ent.File
+	if byPassword {
+		// Content encrypted by password is stored on its own, checksums are left out as they would reveal the plaintext
+		key := filelib.GetKey(s.cfg, &ent.File{Token: token, FileName: name, FileSize: size})
+		var passwordKey string
+		passwordKey, err = s.bs.PutWithPassword(s.ctx, tmp, key, size, opt.Password)
+		if err == nil {
+			file, err = create(s.dc.File.Create()).SetPasswordKey(passwordKey).Save(s.ctx)
+			if err != nil {
+				s.st.Delete(context.Background(), key)
+			}
+		}
+	} else {
+		// Move content into blob store and save metadata referencing it
+		file, err = s.bs.Put(s.ctx, tmp, sum.SHA256, size, func(tx *ent.Tx, blobID string) (*ent.File, error) {
+			return create(tx.File.Create()).
+				SetSha256(sum.SHA256).
+				SetMd5(sum.MD5).
+				SetBlobID(blobID).
+				Save(s.ctx)
+		})
+		if err != nil {
+			os.Remove(tmp)
+		}
+	}
+	if err != nil {
+		if allowReply {
+			rp.Error(reply.CodeServerError, "Error while saving file metadata", err.Error()).Fail()
+		}
+		return nil, err
+	}
+
+	return file, nil
+}
+
 // SERVICES
 
-func (s *AttachedGinFile) GetMany(offset int) ([]*ent.File, error) {
-	return s.dc.File.Query().Where().Offset(offset).Limit(config.PAGINATION_LIMIT).All(s.ctx)
+// DeleteExpired removes every expired file from storage and database, writing the batch into a delete log in logPath.
+func (s *File) DeleteExpired(ctx context.Context, logPath string) ([]*ent.File, error) {
+	files, err := s.dc.File.Query().Where(file.ExpiresAtLTE(time.Now())).All(ctx)
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+
+	// Log is created before removing, so no removed file is missing from it
+	if err := filelib.CreateDeleteLog(s.cfg, files, logPath); err != nil {
+		return nil, err
+	}
+
+	deleted := []*ent.File{}
+	for _, f := range files {
+		// Row is kept on failure so the next run retries it
+		n, err := s.bs.Remove(ctx, f)
+		if err != nil {
+			log.Printf("Error removing expired file %v:\n%v", f.Token, err)
+			continue
+		}
+		// Deleted by a request meanwhile
+		if n > 0 {
+			deleted = append(deleted, f)
+		}
+	}
+	return deleted, nil
+}
+
+// GetMany lists a page of files matching query parameters, along with pagination of the listing.
+// Public listing is the default scope as it is open to anonymous callers.
+func (s *AttachedGinFile) GetMany(allowReply bool) ([]*ent.File, *reply.Pagination, error) {
+	rp := reply.New(s.c)
+	user := authlib.GetUser(s.c)
+
+	fq, err := ParseFileQuery(s.cfg, s.c.Query)
+	if err != nil {
+		if allowReply {
+			rp.Error(reply.CodeBadRequest, err.Error()).Fail()
+		}
+		return nil, nil, err
+	}
+
+	q := s.dc.File.Query().Where(file.ExpiresAtGT(time.Now()))
+
+	switch fq.Scope {
+	case "", ScopePublic:
+		q.Where(file.VisibilityEQ(file.VisibilityPublic))
+	case ScopeMine:
+		if user == nil {
+			if allowReply {
+				rp.Error(reply.CodeUnauthorized, "Please login to list your files").Fail()
+			}
+			return nil, nil, errors.New("login required")
+		}
+		q.Where(file.OwnerID(user.ID))
+	case ScopeAll:
+		if user == nil || !s.cfg.IsAdmin(user.Username) {
+			if allowReply {
+				rp.Error(reply.CodeForbidden, "Only admins can list every file").Fail()
+			}
+			return nil, nil, errors.New("admin required")
+		}
+	default:
+		if allowReply {
+			rp.Error(reply.CodeBadRequest, "Please use scope 'public', 'mine' or 'all'").Fail()
+		}
+		return nil, nil, errors.New("invalid scope")
+	}
+	q.Where(fq.Filters...)
+
+	// Total counts every matching file, not only the ones after cursor
+	total, err := q.Clone().Count(s.ctx)
+	if err != nil {
+		if allowReply {
+			s.replyDbError(err)
+		}
+		return nil, nil, err
+	}
+
+	if fq.Cursor != nil {
+		q.Where(fq.after())
+	}
+
+	// Fetch one more file to know whether a next page exists
+	files, err := q.Order(fq.order()...).Offset(fq.Offset).Limit(fq.Limit + 1).All(s.ctx)
+	if err != nil {
+		if allowReply {
+			s.replyDbError(err)
+		}
+		return nil, nil, err
+	}
+
+	page := &reply.Pagination{Total: total, Limit: fq.Limit}
+	if len(files) > fq.Limit {
+		files = files[:fq.Limit]
+		page.NextCursor = fq.nextCursor(files[len(files)-1])
+	}
+	return files, page, nil
 }
 
 func (s *AttachedGinFile) GetOne(token string, allowReply bool) (*ent.File, error) {
-	f, err := s.dc.File.Query().Where(file.Token(token)).First(s.ctx)
+	return s.getOne(file.Token(token), allowReply)
+}
+
+// getOne returns the file matching where.
+func (s *AttachedGinFile) getOne(where predicate.File, allowReply bool) (*ent.File, error) {
+	f, err := s.dc.File.Query().Where(where).First(s.ctx)
+
+	// Treat expired files as gone even before the reaper deletes them
+	if err == nil && filelib.IsExpired(f) {
+		f, err = nil, ErrExpired
+	}
 
 	if allowReply && err != nil {
 		s.replyDbError(err)
@@ -67,68 +740,457 @@ func (s *AttachedGinFile) GetOne(token string, allowReply bool) (*ent.File, erro
 }
 
 func (s *AttachedGinFile) DeleteOne(token string, allowReply bool) (int, error) {
-	f, err := s.dc.File.Delete().Where(file.Token(token)).Exec(s.ctx)
+	// Resolve first so expired files are treated as gone
+	f, err := s.GetOne(token, allowReply)
+	if err != nil {
+		return 0, err
+	}
+
+	// Content goes along with the row
+	n, err := s.bs.Remove(s.ctx, f)
 
 	if allowReply && err != nil {
 		s.replyDbError(err)
 		return 0, err
 	}
 
-	return f, err
+	return n, err
 }
 
-func (s *AttachedGinFile) DeleteOneFile(file *ent.File, allowReply bool) error {
-	path := filelib.GetPathname(file)
-	err := os.Remove(path)
+func (s *AttachedGinFile) UpdateOne(f *ent.File, edit *FileEdit, allowReply bool) (*ent.File, error) {
+	rp := reply.New(s.c)
+	fail := func(message string) (*ent.File, error) {
+		if allowReply {
+			rp.Error(reply.CodeBadRequest, message).Fail()
+		}
+		return nil, errors.New(message)
+	}
+
+	q := s.dc.File.UpdateOne(f)
+	changed := false
+
+	if edit.FileName != nil {
+		name := strings.TrimSpace(*edit.FileName)
+		if filelib.IsClientEncrypted(f) {
+			return fail("File name is encrypted by the client, it can not be changed")
+		}
+		if !filelib.IsValidFileName(name) {
+			return fail("File name can not be empty or contain path separators")
+		}
+		q.SetFileName(name)
+		changed = true
+	}
+
+	if edit.Password != nil && edit.RemovePassword {
+		return fail("Please use either 'password' or 'remove-password', not both")
+	}
+	if edit.Password != nil {
+		if *edit.Password == "" {
+			return fail("Password can not be empty, use 'remove-password' to remove it")
+		}
+		// Data key is wrapped by the password, so it's unwrapped with the current one and wrapped again
+		if filelib.IsPasswordEncrypted(f) {
+			if !filelib.IsPasswordCorrect(f, edit.CurrentPassword) {
+				return fail("Please send current password in 'current-password', content is encrypted by it")
+			}
+			passwordKey, err := s.bs.RewrapPassword(*f.PasswordKey, edit.CurrentPassword, *edit.Password)
+			if err != nil {
+				if allowReply {
+					rp.Error(reply.CodeServerError, "Error cannot encrypt file by new password", err.Error()).Fail()
+				}
+				return nil, err
+			}
+			q.SetPasswordKey(passwordKey)
+		}
+		q.SetPassword(crypto.HashPassword(*edit.Password)).SetFailedAttempts(0).ClearLockedUntil()
+		changed = true
+	}
+	if edit.RemovePassword {
+		if filelib.IsPasswordEncrypted(f) {
+			return fail("Password of a file encrypted by password can not be removed")
+		}
+		q.ClearPassword().SetFailedAttempts(0).ClearLockedUntil()
+		changed = true
+	}
+
+	if edit.MaxDownloads != nil && edit.RemoveMaxDownloads {
+		return fail("Please use either 'max-downloads' or 'remove-max-downloads', not both")
+	}
+	if edit.MaxDownloads != nil {
+		if *edit.MaxDownloads < 1 {
+			return fail("Max downloads must be greater than 0")
+		}
+		q.SetMaxDownloads(*edit.MaxDownloads)
+		changed = true
+	}
+	if edit.RemoveMaxDownloads {
+		q.ClearMaxDownloads()
+		changed = true
+	}
+
+	if edit.ResetDownloadCount {
+		q.SetDownloadCount(0)
+		changed = true
+	}
+
+	if edit.Visibility != nil {
+		vis, err := ParseVisibility(*edit.Visibility)
+		if err != nil {
+			return fail(err.Error())
+		}
+		q.SetVisibility(vis)
+		changed = true
+	}
+
+	expiry, err := timelib.ParseExpiry(edit.ExpiresIn, edit.ExpiresAt, s.cfg.MaxExpiry)
 	if err != nil {
-		reply.New(s.c).Error(reply.CodeServerError, "Error cannot remove file").Fail()
-		return err
+		return fail(err.Error())
+	}
+	if expiry != nil {
+		q.SetExpiresAt(*expiry)
+		changed = true
+	}
+
+	if !changed {
+		return fail("Nothing to update")
+	}
+
+	// Stored key of legacy files follows file_name, so move it along
+	oldKey := filelib.GetKey(s.cfg, f)
+	newKey := oldKey
+	if edit.FileName != nil {
+		newKey = filelib.GetKey(s.cfg, &ent.File{Token: f.Token, FileName: strings.TrimSpace(*edit.FileName), FileSize: f.FileSize, BlobID: f.BlobID})
+	}
+	if newKey != oldKey {
+		if err := storage.Move(s.ctx, s.st, oldKey, newKey); err != nil {
+			if allowReply {
+				rp.Error(reply.CodeServerError, "Error cannot rename file", err.Error()).Fail()
+			}
+			return nil, err
+		}
+	}
+
+	updated, err := q.Save(s.ctx)
+	if err != nil {
+		// Rollback: restore stored key if update fails
+		if newKey != oldKey {
+			storage.Move(context.Background(), s.st, newKey, oldKey)
+		}
+		if allowReply {
+			s.replyDbError(err)
+		}
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *AttachedGinFile) CreateDeleteLog(files []*ent.File, path string) error {
+	return filelib.CreateDeleteLog(s.cfg, files, path)
+}
+
+// signDownloadURL signs a short-lived URL of path downloading record id without password, password must be checked by caller.
+// Password hash is signed along, so changing the password revokes it. Content encrypted by password can't be downloaded by it,
+// as it doesn't carry the password to decrypt it.
+func signDownloadURL(cfg *config.Config, path, id string, password *string) *SignedURL {
+	expires := time.Now().Add(time.Duration(cfg.DownloadURLExpiry) * time.Second)
+	q := url.Values{
+		"expires":   {strconv.FormatInt(expires.Unix(), 10)},
+		"signature": {filelib.SignDownload(cfg.SigningKey, id, password, expires)},
+	}
+	return &SignedURL{path + "?" + q.Encode(), expires}
+}
+
+// verifyDownloadURL checks signature of download URL requested for record id, see signDownloadURL.
+func verifyDownloadURL(c *gin.Context, cfg *config.Config, id string, password *string, allowReply bool) error {
+	if !filelib.IsDownloadSignatureValid(cfg.SigningKey, id, password, c.Query("expires"), c.Query("signature")) {
+		if allowReply {
+			reply.New(c).Error(reply.CodeForbidden, "Download URL is invalid or expired, please request a new one").Fail()
+		}
+		return errors.New("invalid download url")
 	}
 	return nil
 }
 
-func (s *AttachedGinFile) SendToDownload(file *ent.File) error {
-	err := s.dc.File.UpdateOneID(file.ID).AddDownloadCount(1).Exec(s.ctx)
-	s.c.FileAttachment(
-		filelib.GetPathname(file),
-		file.FileName,
+// claimDownload atomically takes a download slot of a record, concurrent claims never exceed max downloads.
+// Increment adds a download to the record only while a slot is free, returning rows updated. Slots of also are taken
+// in the same transaction, then get reads the record claimed.
+func claimDownload[E any](ctx context.Context, dc *ent.Client, increment func(tx *ent.Tx) (int, error), also func(tx *ent.Tx) error, get func(tx *ent.Tx) (E, error)) (E, error) {
+	var none E
+	tx, err := dc.Tx(ctx)
+	if err != nil {
+		return none, err
+	}
+
+	n, err := increment(tx)
+	if err == nil && n == 0 {
+		err = ErrMaxDownloads
+	}
+	if err == nil && also != nil {
+		err = also(tx)
+	}
+	var claimed E
+	if err == nil {
+		claimed, err = get(tx)
+	}
+	if err != nil {
+		tx.Rollback()
+		return none, err
+	}
+	return claimed, tx.Commit()
+}
+
+// lockout returns how long a record is locked after attempts wrong passwords in a row, doubling from LockoutBase.
+func lockout(cfg *config.Config, attempts int) time.Duration {
+	longest := time.Duration(cfg.LockoutMax) * time.Minute
+	d := time.Duration(cfg.LockoutBase) * time.Second
+	for range attempts - cfg.LockoutThreshold {
+		if d >= longest {
+			break
+		}
+		d *= 2
+	}
+	return min(d, longest)
+}
+
+// lockoutUpdate is update builder of a record locked out after too many wrong passwords, e.g. *ent.FileUpdateOne.
+type lockoutUpdate[U, E any] interface {
+	AddFailedAttempts(int) U
+	SetFailedAttempts(int) U
+	SetLockedUntil(time.Time) U
+	ClearLockedUntil() U
+	Exec(context.Context) error
+	Save(context.Context) (E, error)
+}
+
+// checkLockout verifies password of a record with failed wrong passwords in a row, locked until lockedUntil.
+// Every wrong password from LockoutThreshold on locks it exponentially longer, a locked record refuses even the right password
+// until the lockout is over. Update starts an update of the record, failedOf reads wrong passwords of the record saved.
+func checkLockout[U lockoutUpdate[U, E], E any](ctx context.Context, cfg *config.Config, failed int, lockedUntil *time.Time, correct func() bool, update func() U, failedOf func(E) int) error {
+	if lockedUntil != nil && time.Now().Before(*lockedUntil) {
+		return ErrLocked
+	}
+
+	if correct() {
+		if failed > 0 {
+			update().SetFailedAttempts(0).ClearLockedUntil().Exec(ctx)
+		}
+		return nil
+	}
+
+	// Increment in database, so concurrent guesses are all counted
+	saved, err := update().AddFailedAttempts(1).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n := failedOf(saved); n >= cfg.LockoutThreshold {
+		if err := update().SetLockedUntil(time.Now().Add(lockout(cfg, n))).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return ErrWrongPass
+}
+
+// replyLockout replies error of checkLockout for a record called what locked until lockedUntil, reporting false for other errors.
+func replyLockout(c *gin.Context, err error, lockedUntil *time.Time, what string) bool {
+	rp := reply.New(c)
+	switch {
+	case errors.Is(err, ErrLocked):
+		rp.Error(reply.CodeTooMany, "Too many wrong passwords, "+what+" is locked for a while").
+			SetRetryAfter(time.Until(*lockedUntil)).
+			Fail()
+	case errors.Is(err, ErrWrongPass):
+		rp.Error(reply.CodeBadRequest, "Wrong password").Fail()
+	default:
+		return false
+	}
+	return true
+}
+
+// CheckPassword verifies password of f, locking f out after too many wrong passwords, see checkLockout.
+func (s *AttachedGinFile) CheckPassword(f *ent.File, password string, allowReply bool) error {
+	if f.Password == nil {
+		return nil
+	}
+
+	err := checkLockout(s.ctx, s.cfg, f.FailedAttempts, f.LockedUntil,
+		func() bool { return filelib.IsPasswordCorrect(f, password) },
+		func() *ent.FileUpdateOne { return s.dc.File.UpdateOne(f) },
+		func(f *ent.File) int { return f.FailedAttempts },
 	)
-	file.DownloadCount++
+	if allowReply && err != nil && !replyLockout(s.c, err, f.LockedUntil, "file") {
+		s.replyDbError(err)
+	}
 	return err
 }
 
-func (s *AttachedGinFile) ProcessUpload(allowReply bool) (*ent.File, error) {
+// CreateDownloadURL signs a short-lived URL downloading f without password, password must be checked by caller.
+func (s *AttachedGinFile) CreateDownloadURL(f *ent.File) *SignedURL {
+	return signDownloadURL(s.cfg, "/files/"+f.Token+"/download", f.ID, f.Password)
+}
+
+// VerifyDownloadURL checks signature of download URL requested for f.
+func (s *AttachedGinFile) VerifyDownloadURL(f *ent.File, allowReply bool) error {
+	return verifyDownloadURL(s.c, s.cfg, f.ID, f.Password, allowReply)
+}
+
+// ClaimDownload atomically takes a download slot of f, concurrent claims never exceed max downloads.
+// Slots of also (e.g. of a presigned link) are taken in the same transaction, nothing is taken if any of them is used up.
+func (s *AttachedGinFile) ClaimDownload(f *ent.File, also func(tx *ent.Tx) error) (*ent.File, error) {
+	return claimDownload(s.ctx, s.dc,
+		func(tx *ent.Tx) (int, error) {
+			return tx.File.Update().
+				Where(file.ID(f.ID), file.Or(file.MaxDownloadsIsNil(), hasDownloadSlot)).
+				AddDownloadCount(1).
+				Save(s.ctx)
+		},
+		also,
+		func(tx *ent.Tx) (*ent.File, error) { return tx.File.Get(s.ctx, f.ID) },
+	)
+}
+
+// SendToDownload streams file with Range and conditional request support, every transfer claims a download slot and hands a claim back,
+// ranges carrying the claim of scope resume that download without claiming again. Password decrypts content encrypted by password.
+// Slots of also are claimed along with the file's, see ClaimDownload.
+func (s *AttachedGinFile) SendToDownload(f *ent.File, password, scope string, also func(tx *ent.Tx) error) error {
+	rp := reply.New(s.c)
+
+	// Content never changes after upload, so creation time is its modification time
+	etag := filelib.GetETag(f)
+	digest := filelib.GetDigest(f)
+	modtime := f.CreatedAt
+
+	// Content is opened before a slot is claimed, so failing to read or decrypt it doesn't use up a download
+	content, err := s.bs.GetContent(s.ctx, f)
+	if err == nil {
+		_, err = s.st.Stat(s.ctx, content.Key)
+	}
+	if err != nil {
+		rp.Error(reply.CodeServerError, "Error cannot open file").Fail()
+		return err
+	}
+
+	// Content is read lazily, so only the requested range is fetched from storage
+	rc, err := s.bs.Open(s.ctx, content, password)
+	if err != nil {
+		rp.Error(reply.CodeServerError, "Error cannot open file", err.Error()).Fail()
+		return err
+	}
+	defer rc.Close()
+
+	// Every transfer counts unless it is a range of a download already claimed, so dropped downloads resume
+	// while a used up file serves nothing to anyone else. Ranges from the first byte fetch it all again, so they count.
+	r := s.c.Request
+	resumed := httplib.IsResuming(r, etag, modtime, f.FileSize) && s.hasClaim(f, etag, scope)
+	if httplib.IsContentSent(r, etag, modtime) && !resumed {
+		claimed, err := s.ClaimDownload(f, also)
+		if errors.Is(err, ErrMaxDownloads) {
+			rp.Error(reply.CodeBadRequest, "Max download reached").Fail()
+			return err
+		}
+		if errors.Is(err, ErrLinkMaxDownloads) {
+			rp.Error(reply.CodeForbidden, "Max download of this link reached").Fail()
+			return err
+		}
+		if err != nil {
+			s.replyDbError(err)
+			return err
+		}
+		*f = *claimed
+		s.setClaim(f, etag, scope)
+	} else if !resumed && !filelib.HasDownloadSlot(f) {
+		rp.Error(reply.CodeBadRequest, "Max download reached").Fail()
+		return ErrMaxDownloads
+	}
+
+	s.c.Header("ETag", etag)
+	if digest != "" {
+		s.c.Header("Digest", digest)
+	}
+	s.c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": f.FileName}))
+	http.ServeContent(s.c.Writer, r, f.FileName, modtime, rc)
+	return nil
+}
+
+// hasClaim reports whether request carries a valid claim of f in header or cookie.
+func (s *AttachedGinFile) hasClaim(f *ent.File, etag, scope string) bool {
+	claim := s.c.GetHeader(claimHeader)
+	if claim == "" {
+		claim, _ = s.c.Cookie(claimCookie)
+	}
+	return claim != "" && filelib.IsClaimValid(s.cfg.SigningKey, f.ID, etag, scope, claim)
+}
+
+// setClaim hands claim of download just counted to client, in header for API clients and in cookie for browsers.
+func (s *AttachedGinFile) setClaim(f *ent.File, etag, scope string) {
+	expiry := time.Duration(s.cfg.DownloadClaimExpiry) * time.Minute
+	claim := filelib.SignClaim(s.cfg.SigningKey, f.ID, etag, scope, time.Now().Add(expiry))
+
+	s.c.Header(claimHeader, claim)
+	s.c.SetSameSite(http.SameSiteLaxMode)
+	s.c.SetCookie(claimCookie, claim, int(expiry.Seconds()), s.c.Request.URL.Path, "", s.c.Request.TLS != nil, true)
+}
+
+// ReadUploadForm validates request size and returns every file sent in 'file' form fields.
+func (s *AttachedGinFile) ReadUploadForm(allowReply bool) ([]*multipart.FileHeader, error) {
 	rp := reply.New(s.c)
 
 	// Validate max size
 	contentLength := s.c.Request.ContentLength
-	if contentLength > config.MAX_UPLOAD*config.MB {
+	if contentLength > s.cfg.MaxUpload*config.MB {
 		if allowReply {
 			rp.Error(
 				reply.CodeBadRequest,
-				fmt.Sprintf("Max uploaded file is %vMB", config.MAX_UPLOAD),
+				fmt.Sprintf("Max uploaded file is %vMB", s.cfg.MaxUpload),
 				fmt.Sprintf("File size: %.2fMB", float64(contentLength)/float64(config.MB)),
 			).Fail()
 		}
 		return nil, fmt.Errorf("file too large")
 	}
 
-	// Hard validate max size
-	s.c.Request.Body = http.MaxBytesReader(s.c.Writer, s.c.Request.Body, (config.MAX_UPLOAD*config.MB)+(10*config.MB))
+	// Reject request over storage quota before the form is read, exact sizes are checked once it's parsed
+	if err := s.checkQuota(1, max(contentLength-formOverhead, 0), 0, allowReply); err != nil {
+		return nil, err
+	}
+
+	// Hard validate max size, and quota left to the user as length of chunked requests is unknown
+	limit := (s.cfg.MaxUpload * config.MB) + (10 * config.MB)
+	overQuota := false
+	if u := authlib.GetUser(s.c); u != nil {
+		usage, err := s.qs.GetUsage(s.ctx, u)
+		if err != nil {
+			if allowReply {
+				s.replyDbError(err)
+			}
+			return nil, err
+		}
+		if remaining := usage.Remaining(); remaining >= 0 && remaining+formOverhead < limit {
+			limit = remaining + formOverhead
+			overQuota = true
+		}
+	}
+	s.c.Request.Body = http.MaxBytesReader(s.c.Writer, s.c.Request.Body, limit)
 
-	// Get file and optional parameters from form
-	u, err := s.c.FormFile("file")
-	p := s.c.Request.FormValue("password")
-	maxDownloads := s.c.Request.FormValue("max-downloads")
+	// Get files from form
+	form, err := s.c.MultipartForm()
+	if err == nil && len(form.File["file"]) == 0 {
+		err = errors.New("http: no such file")
+	}
 
 	if err != nil {
 		s.c.Request.Body.Close()
 		// Validate max size
 		if strings.Contains(err.Error(), "http: request body too large") {
-			if allowReply {
+			if allowReply && overQuota {
+				rp.Error(
+					reply.CodeQuota,
+					"Quota exceeded, upload is larger than what your plan has left",
+					fmt.Sprintf("left: %.2fMB", float64(limit-formOverhead)/float64(config.MB)),
+				).Fail()
+			} else if allowReply {
 				rp.Error(
 					reply.CodeBadRequest,
-					fmt.Sprintf("Max file to upload is %vMB", config.MAX_UPLOAD),
+					fmt.Sprintf("Max file to upload is %vMB", s.cfg.MaxUpload),
 				).Fail()
 			}
 			return nil, err
@@ -139,69 +1201,47 @@ func (s *AttachedGinFile) ProcessUpload(allowReply bool) (*ent.File, error) {
 		}
 		return nil, err
 	}
-	defer s.c.Request.Body.Close()
 
 	// Validate max size
-	if u.Size > config.MAX_UPLOAD*config.MB {
-		if allowReply {
-			rp.Error(
-				reply.CodeBadRequest,
-				fmt.Sprintf("Max uploaded file is %vMB", config.MAX_UPLOAD),
-				fmt.Sprintf("File size: %.2fMB", float64(u.Size)/float64(config.MB)),
-			).Fail()
-		}
-		return nil, fmt.Errorf("file too large")
-	}
-
-	// Ensure upload directories exist
-	if err := filelib.CreateDir(); err != nil {
-		if allowReply {
-			rp.Error(reply.CodeServerError, "Error creating directories for upload file", err.Error()).Fail()
+	for _, u := range form.File["file"] {
+		if u.Size > s.cfg.MaxUpload*config.MB {
+			if allowReply {
+				rp.Error(
+					reply.CodeBadRequest,
+					fmt.Sprintf("Max uploaded file is %vMB", s.cfg.MaxUpload),
+					fmt.Sprintf("File size of %v: %.2fMB", u.Filename, float64(u.Size)/float64(config.MB)),
+				).Fail()
+			}
+			return nil, fmt.Errorf("file too large")
 		}
-		return nil, err
-	}
-
-	// Detect MIME type from header, fallback to "unknown"
-	mime := u.Header.Get("Content-Type")
-	if mime == "" {
-		mime = "unknown"
-	}
-
-	// Build database query with file metadata
-	q := s.dc.File.Create().SetFileName(u.Filename).SetFileSize(u.Size).SetMime(mime)
-
-	// Set optional password if provided
-	if p != "" {
-		q.SetPassword(crypto.HashPassword(p))
 	}
 
-	// Set max downloads limit if valid number provided
-	if md, err := strconv.Atoi(maxDownloads); err == nil {
-		q.SetMaxDownloads(md)
-	}
+	return form.File["file"], nil
+}
 
-	// Save metadata to database first to get generated ID
-	file, err := q.Save(s.ctx)
+func (s *AttachedGinFile) ProcessUpload(u *multipart.FileHeader, allowReply bool) (*UploadedFile, error) {
+	// Get and validate optional parameters from form
+	opt, err := ParseUploadOptions(s.cfg, s.c.Request.FormValue)
 	if err != nil {
 		if allowReply {
-			rp.Error(reply.CodeServerError, "Error while saving file metadata", err.Error()).Fail()
+			reply.New(s.c).Error(reply.CodeBadRequest, err.Error()).Fail()
 		}
 		return nil, err
 	}
 
-	// Generate file path using ID from database
-	pathname := filelib.GetPathname(file)
-
-	// Save physical file to disk
-	if err := s.c.SaveUploadedFile(u, pathname); err != nil {
-		// Rollback: delete database record if file save fails
-		s.dc.File.DeleteOneID(file.ID).ExecX(s.ctx)
+	// Held until the file is saved, so concurrent uploads are checked against it
+	unlock := s.qs.Lock(authlib.GetUser(s.c))
+	defer unlock()
+	if err := s.checkFilesQuota([]*multipart.FileHeader{u}, allowReply); err != nil {
+		return nil, err
+	}
 
-		if allowReply {
-			rp.Error(reply.CodeServerError, "Error while saving file to disk", err.Error()).Fail()
-		}
+	// Management token is stored hashed
+	manageToken := crypto.CreateSecret(s.cfg.SecretLength)
+	file, err := s.store(u, opt, crypto.HashToken(manageToken), nil, allowReply)
+	if err != nil {
 		return nil, err
 	}
 
-	return file, nil
+	return &UploadedFile{file, manageToken}, nil
 }
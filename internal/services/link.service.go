@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"file-sharing/config"
+	"file-sharing/ent"
+	"file-sharing/ent/file"
+	"file-sharing/ent/link"
+	"file-sharing/ent/predicate"
+	"file-sharing/internal/lib/crypto"
+	"file-sharing/internal/lib/filelib"
+	"file-sharing/internal/lib/reply"
+	"file-sharing/internal/lib/timelib"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+)
+
+// Expiry of presigned link when none is given
+const defaultLinkExpiry = 15 * time.Minute
+
+// Link mints presigned download URLs of files, they carry neither share token nor password.
+// Constraints are signed into the URL, only links limited to a number of downloads are stored to count them.
+type Link struct {
+	dc  *ent.Client
+	fs  *File
+	cfg *config.Config
+}
+
+// LinkOptions holds constraints of a presigned link, every field is optional.
+type LinkOptions struct {
+	ExpiresIn    string `form:"expires-in" json:"expires-in"`
+	ExpiresAt    string `form:"expires-at" json:"expires-at"`
+	IP           string `form:"ip" json:"ip"` // Client IP or CIDR allowed to download
+	MaxDownloads *int   `form:"max-downloads" json:"max-downloads"`
+}
+
+type AttachedGinLink struct {
+	dc  *ent.Client
+	fs  *AttachedGinFile
+	cfg *config.Config
+	c   *gin.Context
+	ctx context.Context
+}
+
+// INIT
+
+func NewLink(client *ent.Client, fs *File, cfg *config.Config) *Link {
+	return &Link{dc: client, fs: fs, cfg: cfg}
+}
+
+func (s *Link) AttachGin(c *gin.Context) *AttachedGinLink {
+	return &AttachedGinLink{s.dc, s.fs.AttachGin(c), s.cfg, c, c.Request.Context()}
+}
+
+// PRIVATE UTIL
+
+var ErrLinkMaxDownloads = errors.New("max downloads of link reached")
+
+// hasLinkDownloadSlot compares download_count against max_downloads column in database.
+var hasLinkDownloadSlot = predicate.Link(sql.FieldsLT(link.FieldDownloadCount, link.FieldMaxDownloads))
+
+// isIPAllowed reports whether client ip matches allowed IP or CIDR.
+func isIPAllowed(allowed, ip string) bool {
+	client := net.ParseIP(ip)
+	if client == nil {
+		return false
+	}
+	if _, n, err := net.ParseCIDR(allowed); err == nil {
+		return n.Contains(client)
+	}
+	return client.Equal(net.ParseIP(allowed))
+}
+
+// SERVICES
+
+// DeleteExpired removes download counters of expired links.
+func (s *Link) DeleteExpired(ctx context.Context) (int, error) {
+	return s.dc.Link.Delete().Where(link.ExpiresAtLTE(time.Now())).Exec(ctx)
+}
+
+// Create mints a presigned link downloading f within constraints of opt, authorization must be checked by caller.
+func (s *AttachedGinLink) Create(f *ent.File, opt *LinkOptions, allowReply bool) (*SignedURL, error) {
+	rp := reply.New(s.c)
+	fail := func(message string) (*SignedURL, error) {
+		if allowReply {
+			rp.Error(reply.CodeBadRequest, message).Fail()
+		}
+		return nil, errors.New(message)
+	}
+
+	if filelib.IsPasswordEncrypted(f) {
+		return fail("File is encrypted by its password, presigned links can't download it as they carry no password")
+	}
+
+	expires := time.Now().Add(defaultLinkExpiry)
+	expiry, err := timelib.ParseExpiry(opt.ExpiresIn, opt.ExpiresAt, s.cfg.MaxExpiry)
+	if err != nil {
+		return fail(err.Error())
+	}
+	if expiry != nil {
+		expires = *expiry
+	}
+
+	q := url.Values{
+		"expires": {strconv.FormatInt(expires.Unix(), 10)},
+		"nonce":   {crypto.CreateToken(s.cfg.TokenLength)},
+	}
+	if ip := strings.TrimSpace(opt.IP); ip != "" {
+		if _, _, err := net.ParseCIDR(ip); err != nil && net.ParseIP(ip) == nil {
+			return fail("Please use an IP like 203.0.113.7 or a CIDR like 203.0.113.0/24")
+		}
+		q.Set("ip", ip)
+	}
+	if opt.MaxDownloads != nil {
+		if *opt.MaxDownloads < 1 {
+			return fail("Max downloads must be at least 1")
+		}
+		q.Set("max", strconv.Itoa(*opt.MaxDownloads))
+
+		err := s.dc.Link.Create().
+			SetID(q.Get("nonce")).
+			SetFileID(f.ID).
+			SetMaxDownloads(*opt.MaxDownloads).
+			SetExpiresAt(expires).
+			Exec(s.ctx)
+		if err != nil {
+			if allowReply {
+				s.fs.replyDbError(err)
+			}
+			return nil, err
+		}
+	}
+
+	q.Set("signature", filelib.SignLink(s.cfg.SigningKey, f.ID, q))
+	return &SignedURL{"/files/presigned/" + f.ID + "?" + q.Encode(), expires}, nil
+}
+
+// GetFile verifies presigned link of file id requested, then returns the file. No password is checked.
+func (s *AttachedGinLink) GetFile(id string, allowReply bool) (*ent.File, error) {
+	rp := reply.New(s.c)
+	q := s.c.Request.URL.Query()
+	fail := func(message string) (*ent.File, error) {
+		if allowReply {
+			rp.Error(reply.CodeForbidden, message).Fail()
+		}
+		return nil, errors.New(message)
+	}
+
+	// Signature first, so forged links never reach database
+	if !filelib.IsLinkSignatureValid(s.cfg.SigningKey, id, q) {
+		return fail("Invalid link signature")
+	}
+	expires, err := strconv.ParseInt(q.Get("expires"), 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return fail("Link was expired, please ask for a new one")
+	}
+	if ip := q.Get("ip"); ip != "" && !isIPAllowed(ip, s.c.ClientIP()) {
+		return fail("Link can not be used from your IP")
+	}
+
+	return s.fs.getOne(file.ID(id), allowReply)
+}
+
+// ClaimDownload returns claim taking a download slot of link requested for f in the transaction claiming f,
+// so a link is only used up by a download actually served. Links without a limit take nothing.
+func (s *AttachedGinLink) ClaimDownload(f *ent.File) func(tx *ent.Tx) error {
+	nonce := s.c.Query("nonce")
+	if s.c.Query("max") == "" {
+		return nil
+	}
+
+	return func(tx *ent.Tx) error {
+		n, err := tx.Link.Update().
+			Where(link.ID(nonce), link.FileID(f.ID), hasLinkDownloadSlot).
+			AddDownloadCount(1).
+			Save(s.ctx)
+		if err == nil && n == 0 {
+			err = ErrLinkMaxDownloads
+		}
+		return err
+	}
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	stdsql "database/sql"
+	"file-sharing/config"
+	"file-sharing/ent"
+	"file-sharing/ent/file"
+	"file-sharing/ent/upload"
+	"file-sharing/internal/lib/reply"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+// Striped locks serializing uploads of the same user from checking quota until they are saved
+var quotaLocks [64]sync.Mutex
+
+// Quotas a user can exceed
+const (
+	QuotaBytes    = "max_bytes"
+	QuotaShares   = "max_shares"
+	QuotaFileSize = "max_file_size"
+)
+
+// Quota accounts uploads of users against quota of their plan.
+type Quota struct {
+	dc  *ent.Client
+	cfg *config.Config
+}
+
+// Usage is what a user has uploaded so far along with limits of their plan in bytes, zero limit means unlimited.
+// Files count while they are active, unfinished resumable uploads count as they reserve their length.
+type Usage struct {
+	Plan        string `json:"plan"`
+	Bytes       int64  `json:"bytes"`
+	Shares      int    `json:"shares"`
+	MaxBytes    int64  `json:"max_bytes"`
+	MaxShares   int    `json:"max_shares"`
+	MaxFileSize int64  `json:"max_file_size"`
+}
+
+// QuotaError tells which quota an upload would exceed.
+type QuotaError struct {
+	Quota     string // One of Quota constants
+	Limit     int64
+	Used      int64
+	Requested int64
+}
+
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("quota %v exceeded", e.Quota)
+}
+
+// Remaining returns bytes u can still upload, -1 if unlimited.
+// Besides storage quota, remaining shares of max file size at most are left.
+func (u *Usage) Remaining() int64 {
+	remaining := int64(-1)
+	if u.MaxBytes > 0 {
+		remaining = max(u.MaxBytes-u.Bytes, 0)
+	}
+	if u.MaxShares > 0 && u.MaxFileSize > 0 {
+		byShares := int64(max(u.MaxShares-u.Shares, 0)) * u.MaxFileSize
+		if remaining < 0 || byShares < remaining {
+			remaining = byShares
+		}
+	}
+	return remaining
+}
+
+// INIT
+
+func NewQuota(client *ent.Client, cfg *config.Config) *Quota {
+	return &Quota{dc: client, cfg: cfg}
+}
+
+// UTIL
+
+// Reply explains quota exceeded by e.
+func (e *QuotaError) Reply(rp *reply.Reply) {
+	mb := func(n int64) string {
+		return fmt.Sprintf("%.2fMB", float64(n)/float64(config.MB))
+	}
+
+	switch e.Quota {
+	case QuotaFileSize:
+		rp.Error(
+			reply.CodeQuota,
+			fmt.Sprintf("File size quota exceeded, max file size of your plan is %v", mb(e.Limit)),
+			fmt.Sprintf("quota: %v, file size: %v", e.Quota, mb(e.Requested)),
+		).Fail()
+	case QuotaShares:
+		rp.Error(
+			reply.CodeQuota,
+			fmt.Sprintf("Share quota exceeded, your plan allows %v active files", e.Limit),
+			fmt.Sprintf("quota: %v, active: %v, uploading: %v", e.Quota, e.Used, e.Requested),
+		).Fail()
+	default:
+		rp.Error(
+			reply.CodeQuota,
+			fmt.Sprintf("Storage quota exceeded, your plan allows %v in total", mb(e.Limit)),
+			fmt.Sprintf("quota: %v, used: %v, uploading: %v", e.Quota, mb(e.Used), mb(e.Requested)),
+		).Fail()
+	}
+}
+
+// SERVICES
+
+func (s *Quota) GetUsage(ctx context.Context, u *ent.User) (*Usage, error) {
+	q := s.cfg.GetQuota(u.Plan)
+	usage := &Usage{
+		Plan:        "default",
+		MaxBytes:    q.MaxBytes * config.MB,
+		MaxShares:   q.MaxShares,
+		MaxFileSize: q.MaxFileSize * config.MB,
+	}
+	if u.Plan != nil {
+		if _, ok := s.cfg.Plans[*u.Plan]; ok {
+			usage.Plan = *u.Plan
+		}
+	}
+
+	var v []struct {
+		Sum   stdsql.NullInt64 `json:"sum"`
+		Count int              `json:"count"`
+	}
+
+	err := s.dc.File.Query().
+		Where(file.OwnerID(u.ID), file.ExpiresAtGT(time.Now())).
+		Aggregate(ent.Sum(file.FieldFileSize), ent.Count()).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	usage.Bytes, usage.Shares = v[0].Sum.Int64, v[0].Count
+
+	// Idle uploads are about to be deleted by cron, they don't reserve anything
+	v = v[:0]
+	err = s.dc.Upload.Query().
+		Where(
+			upload.OwnerID(u.ID),
+			upload.UpdatedAtGT(time.Now().Add(-time.Duration(s.cfg.UploadExpiry)*time.Hour)),
+		).
+		Aggregate(ent.Sum(upload.FieldUploadLength), ent.Count()).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	usage.Bytes += v[0].Sum.Int64
+	usage.Shares += v[0].Count
+
+	return usage, nil
+}
+
+// Lock serializes uploads of u until unlocked, so uploads passing Check concurrently can't exceed quota together.
+// It must be held from Check until the upload is saved. Anonymous uploads have no quota and are not locked.
+func (s *Quota) Lock(u *ent.User) func() {
+	if u == nil {
+		return func() {}
+	}
+	h := fnv.New32a()
+	h.Write([]byte(u.ID))
+	m := &quotaLocks[h.Sum32()%uint32(len(quotaLocks))]
+	m.Lock()
+	return m.Unlock
+}
+
+// Check returns *QuotaError if u can't upload shares more files of bytes in total, largest being the biggest one.
+// Nil user is anonymous and has no quota.
+func (s *Quota) Check(ctx context.Context, u *ent.User, shares int, bytes, largest int64) error {
+	if u == nil {
+		return nil
+	}
+
+	usage, err := s.GetUsage(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	if usage.MaxFileSize > 0 && largest > usage.MaxFileSize {
+		return &QuotaError{QuotaFileSize, usage.MaxFileSize, 0, largest}
+	}
+	if usage.MaxShares > 0 && usage.Shares+shares > usage.MaxShares {
+		return &QuotaError{QuotaShares, int64(usage.MaxShares), int64(usage.Shares), int64(shares)}
+	}
+	if usage.MaxBytes > 0 && usage.Bytes+bytes > usage.MaxBytes {
+		return &QuotaError{QuotaBytes, usage.MaxBytes, usage.Bytes, bytes}
+	}
+	return nil
+}
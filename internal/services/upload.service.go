@@ -0,0 +1,442 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"file-sharing/config"
+	"file-sharing/ent"
+	"file-sharing/ent/file"
+	"file-sharing/ent/upload"
+	"file-sharing/internal/lib/authlib"
+	"file-sharing/internal/lib/crypto"
+	"file-sharing/internal/lib/filelib"
+	"file-sharing/internal/lib/reply"
+	"file-sharing/internal/lib/tuslib"
+	"file-sharing/internal/storage"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Upload struct {
+	dc  *ent.Client
+	bs  *Blob
+	qs  *Quota
+	cfg *config.Config
+	// Striped locks serializing writes of the same upload session
+	locks [64]sync.Mutex
+}
+
+type AttachedGinUpload struct {
+	s   *Upload
+	dc  *ent.Client
+	c   *gin.Context
+	ctx context.Context
+}
+
+// CreatedUpload is a freshly created upload session along with its plaintext management token, which is only shown once.
+type CreatedUpload struct {
+	*ent.Upload
+	ManageToken string `json:"manage_token"`
+}
+
+// INIT
+
+func NewUpload(client *ent.Client, st storage.Storage, cfg *config.Config) *Upload {
+	return &Upload{dc: client, bs: NewBlob(client, st, cfg), qs: NewQuota(client, cfg), cfg: cfg}
+}
+
+func (s *Upload) AttachGin(c *gin.Context) *AttachedGinUpload {
+	return &AttachedGinUpload{s, s.dc, c, c.Request.Context()}
+}
+
+// UTIL
+
+// GetExpiry returns the time an idle upload session will be deleted.
+func (s *Upload) GetExpiry(u *ent.Upload) time.Time {
+	return u.UpdatedAt.Add(time.Duration(s.cfg.UploadExpiry) * time.Hour)
+}
+
+// GetMaxSize returns max length of an upload in bytes.
+func (s *Upload) GetMaxSize() int64 {
+	return s.cfg.MaxUpload * config.MB
+}
+
+// PRIVATE UTIL
+
+func (s *Upload) lock(id string) func() {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	m := &s.locks[h.Sum32()%uint32(len(s.locks))]
+	m.Lock()
+	return m.Unlock
+}
+
+func (s *AttachedGinUpload) replyDbError(err error) {
+	rp := reply.New(s.c)
+
+	if errors.Is(err, ErrExpired) {
+		rp.Error(reply.CodeExpired, "Upload session was expired").Fail()
+		return
+	}
+	if ent.IsNotFound(err) {
+		rp.Error(reply.CodeNotFound, "Upload not found. This could be happen because upload was finished or expired").Fail()
+		return
+	}
+	rp.Error(reply.CodeBadGateWay, err.Error()).Fail()
+}
+
+// SERVICES
+
+// DeleteAbandoned removes idle upload sessions and their partial data.
+func (s *Upload) DeleteAbandoned(ctx context.Context) ([]*ent.Upload, error) {
+	idle := func() time.Time { return time.Now().Add(-time.Duration(s.cfg.UploadExpiry) * time.Hour) }
+	uploads, err := s.dc.Upload.Query().Where(upload.UpdatedAtLTE(idle())).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := []*ent.Upload{}
+	for _, u := range uploads {
+		ok, err := s.deleteIdle(ctx, u, idle())
+		if err != nil {
+			return deleted, err
+		}
+		if ok {
+			deleted = append(deleted, u)
+		}
+	}
+	return deleted, nil
+}
+
+// deleteIdle removes upload session unless a write finishing while waiting for its lock made it active again.
+func (s *Upload) deleteIdle(ctx context.Context, u *ent.Upload, idle time.Time) (bool, error) {
+	unlock := s.lock(u.ID)
+	defer unlock()
+
+	n, err := s.dc.Upload.Query().Where(upload.ID(u.ID), upload.UpdatedAtLTE(idle)).Count(ctx)
+	if err != nil || n == 0 {
+		return false, err
+	}
+
+	// Keep the row on failure so the next run retries it
+	if err := os.Remove(filelib.GetPartialPathname(s.cfg, u)); err != nil && !os.IsNotExist(err) {
+		log.Printf("Error removing abandoned upload %v:\n%v", u.ID, err)
+		return false, nil
+	}
+	return true, s.dc.Upload.DeleteOneID(u.ID).Exec(ctx)
+}
+
+func (s *AttachedGinUpload) GetOne(id string, allowReply bool) (*ent.Upload, error) {
+	u, err := s.dc.Upload.Get(s.ctx, id)
+
+	// Treat idle sessions as gone even before the reaper deletes them
+	if err == nil && !s.s.GetExpiry(u).After(time.Now()) {
+		u, err = nil, ErrExpired
+	}
+
+	if allowReply && err != nil {
+		s.replyDbError(err)
+		return nil, err
+	}
+
+	return u, err
+}
+
+func (s *AttachedGinUpload) Create(allowReply bool) (*CreatedUpload, error) {
+	rp := reply.New(s.c)
+	fail := func(code string, status int, message string, details ...string) (*CreatedUpload, error) {
+		if allowReply {
+			rp.Error(code, message, details...).Fail(status)
+		}
+		return nil, errors.New(message)
+	}
+
+	if s.c.GetHeader("Upload-Defer-Length") != "" {
+		return fail(reply.CodeBadRequest, http.StatusBadRequest, "Deferred upload length is not supported")
+	}
+
+	// Validate size
+	length, err := strconv.ParseInt(s.c.GetHeader("Upload-Length"), 10, 64)
+	if err != nil || length < 1 {
+		return fail(reply.CodeBadRequest, http.StatusBadRequest, "Please add a positive 'Upload-Length' header")
+	}
+	if length > s.s.GetMaxSize() {
+		return fail(
+			reply.CodeBadRequest,
+			http.StatusRequestEntityTooLarge,
+			fmt.Sprintf("Max uploaded file is %vMB", s.s.cfg.MaxUpload),
+			fmt.Sprintf("File size: %.2fMB", float64(length)/float64(config.MB)),
+		)
+	}
+
+	// Session reserves its whole length in quota of owner, locked until it is saved
+	unlock := s.s.qs.Lock(authlib.GetUser(s.c))
+	defer unlock()
+	if err := s.s.qs.Check(s.ctx, authlib.GetUser(s.c), 1, length, length); err != nil {
+		var qe *QuotaError
+		if !errors.As(err, &qe) {
+			return fail(reply.CodeBadGateWay, http.StatusBadGateway, err.Error())
+		}
+		if allowReply {
+			qe.Reply(rp)
+		}
+		return nil, err
+	}
+
+	// Get file name, MIME type and optional parameters from metadata
+	meta, err := tuslib.ParseMetadata(s.c.GetHeader("Upload-Metadata"))
+	if err != nil {
+		return fail(reply.CodeBadRequest, http.StatusBadRequest, "Invalid 'Upload-Metadata' header", err.Error())
+	}
+	name := meta["filename"]
+	if !filelib.IsValidFileName(name) {
+		return fail(reply.CodeBadRequest, http.StatusBadRequest, "Please add a valid 'filename' in 'Upload-Metadata' header")
+	}
+	mime := meta["filetype"]
+	if mime == "" {
+		mime = "unknown"
+	}
+	opt, err := ParseUploadOptions(s.s.cfg, func(key string) string { return meta[key] })
+	if err != nil {
+		return fail(reply.CodeBadRequest, http.StatusBadRequest, err.Error())
+	}
+	// Password or metadata would have to be kept until the upload is finished
+	if opt.Encryption != nil {
+		return fail(reply.CodeBadRequest, http.StatusBadRequest, "Encryption is not supported by resumable uploads")
+	}
+
+	// Ensure upload directories exist
+	if err := filelib.CreateDir(s.s.cfg); err != nil {
+		return fail(reply.CodeServerError, http.StatusInternalServerError, "Error creating directories for upload file", err.Error())
+	}
+
+	// Share settings are kept on the session until the file is finished
+	manageToken := crypto.CreateSecret(s.s.cfg.SecretLength)
+	q := s.dc.Upload.Create().
+		SetToken(crypto.CreateToken(s.s.cfg.TokenLength)).
+		SetUploadLength(length).
+		SetFileName(name).
+		SetMime(mime).
+		SetManageToken(crypto.HashToken(manageToken)).
+		SetNillableOwnerID(authlib.GetUserID(s.c)).
+		SetNillableMaxDownloads(opt.MaxDownloads).
+		SetNillableFileExpiresAt(opt.ExpiresAt)
+	if opt.Password != "" {
+		q.SetPassword(crypto.HashPassword(opt.Password))
+	}
+	if opt.ExpectedSHA256 != "" {
+		q.SetExpectedSha256(opt.ExpectedSHA256)
+	}
+	if opt.ExpectedMD5 != "" {
+		q.SetExpectedMd5(opt.ExpectedMD5)
+	}
+	if opt.Visibility != nil {
+		q.SetVisibility(string(*opt.Visibility))
+	}
+
+	u, err := q.Save(s.ctx)
+	if err != nil {
+		return fail(reply.CodeServerError, http.StatusInternalServerError, "Error while saving upload metadata", err.Error())
+	}
+
+	// Create empty file to append chunks on
+	f, err := os.Create(filelib.GetPartialPathname(s.s.cfg, u))
+	if err != nil {
+		// Rollback: delete database record if file creation fails
+		s.dc.Upload.DeleteOneID(u.ID).ExecX(s.ctx)
+		return fail(reply.CodeServerError, http.StatusInternalServerError, "Error while creating upload file", err.Error())
+	}
+	f.Close()
+
+	return &CreatedUpload{u, manageToken}, nil
+}
+
+// Append writes request body at the current offset of upload session, keeping any bytes received before an interrupted connection.
+func (s *AttachedGinUpload) Append(id string, allowReply bool) (*ent.Upload, error) {
+	rp := reply.New(s.c)
+	fail := func(code string, status int, message string, details ...string) (*ent.Upload, error) {
+		if allowReply {
+			rp.Error(code, message, details...).Fail(status)
+		}
+		return nil, errors.New(message)
+	}
+
+	if s.c.ContentType() != tuslib.OffsetType {
+		return fail(reply.CodeBadRequest, http.StatusUnsupportedMediaType, "Content-Type must be "+tuslib.OffsetType)
+	}
+	offset, err := strconv.ParseInt(s.c.GetHeader("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		return fail(reply.CodeBadRequest, http.StatusBadRequest, "Please add a valid 'Upload-Offset' header")
+	}
+
+	unlock := s.s.lock(id)
+	defer unlock()
+
+	// Read after locking so offset is not changed by concurrent request
+	u, err := s.GetOne(id, allowReply)
+	if err != nil {
+		return nil, err
+	}
+	if offset != u.UploadOffset {
+		return fail(
+			reply.CodeConflict,
+			http.StatusConflict,
+			"Upload offset mismatch",
+			fmt.Sprintf("Current offset is %v", u.UploadOffset),
+		)
+	}
+
+	f, err := os.OpenFile(filelib.GetPartialPathname(s.s.cfg, u), os.O_WRONLY, 0644)
+	if err != nil {
+		return fail(reply.CodeServerError, http.StatusInternalServerError, "Error while opening upload file", err.Error())
+	}
+	defer f.Close()
+
+	// Drop stray bytes written by a request which failed to save its offset
+	if err := f.Truncate(offset); err != nil {
+		return fail(reply.CodeServerError, http.StatusInternalServerError, "Error while preparing upload file", err.Error())
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return fail(reply.CodeServerError, http.StatusInternalServerError, "Error while preparing upload file", err.Error())
+	}
+
+	// Never accept more than declared length
+	n, copyErr := io.Copy(f, io.LimitReader(s.c.Request.Body, u.UploadLength-offset))
+
+	u, err = s.dc.Upload.UpdateOne(u).SetUploadOffset(offset + n).Save(s.ctx)
+	if err != nil {
+		if allowReply {
+			s.replyDbError(err)
+		}
+		return nil, err
+	}
+
+	if copyErr != nil {
+		return fail(reply.CodeBadRequest, http.StatusBadRequest, "Error while receiving upload chunk", copyErr.Error())
+	}
+
+	return u, nil
+}
+
+// Finalize turns a fully received upload session into a File stored in the large/small layout.
+func (s *AttachedGinUpload) Finalize(u *ent.Upload, allowReply bool) (*ent.File, error) {
+	rp := reply.New(s.c)
+	fail := func(message string, err error) (*ent.File, error) {
+		if allowReply {
+			rp.Error(reply.CodeServerError, message, err.Error()).Fail()
+		}
+		return nil, err
+	}
+
+	unlock := s.s.lock(u.ID)
+	defer unlock()
+
+	// Read after locking so the session is not finalized twice
+	u, err := s.GetOne(u.ID, allowReply)
+	if err != nil {
+		return nil, err
+	}
+
+	partial := filelib.GetPartialPathname(s.s.cfg, u)
+
+	// Verify the received size
+	info, err := os.Stat(partial)
+	if err != nil {
+		return fail("Error while reading upload file", err)
+	}
+	if info.Size() != u.UploadLength {
+		return fail("Upload size mismatch", fmt.Errorf("received %v of %v bytes", info.Size(), u.UploadLength))
+	}
+
+	sum, err := filelib.HashFile(partial)
+	if err != nil {
+		return fail("Error while reading upload file", err)
+	}
+
+	// Content not matching checksum expected by client can't be resumed, so the session is dropped
+	expectedSHA256, expectedMD5 := "", ""
+	if u.ExpectedSha256 != nil {
+		expectedSHA256 = *u.ExpectedSha256
+	}
+	if u.ExpectedMd5 != nil {
+		expectedMD5 = *u.ExpectedMd5
+	}
+	if err := sum.Verify(expectedSHA256, expectedMD5); err != nil {
+		os.Remove(partial)
+		s.dc.Upload.DeleteOne(u).Exec(s.ctx)
+		if allowReply {
+			rp.Error(reply.CodeBadRequest, err.Error()).Fail()
+		}
+		return nil, err
+	}
+
+	// Type is detected from content, disallowed content can't be resumed either
+	mime, err := detectMime(s.c, s.s.cfg, partial, u.FileName, allowReply)
+	if errors.Is(err, ErrMimeDenied) {
+		os.Remove(partial)
+		s.dc.Upload.DeleteOne(u).Exec(s.ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Move content into blob store, token and management token were given on session creation.
+	// The session is removed along with creating the file, on failure it is kept with its content so finishing can be retried.
+	file, err := s.s.bs.Put(s.ctx, partial, sum.SHA256, u.UploadLength, func(tx *ent.Tx, blobID string) (*ent.File, error) {
+		q := tx.File.Create().
+			SetToken(u.Token).
+			SetFileName(u.FileName).
+			SetFileSize(u.UploadLength).
+			SetMime(mime).
+			SetSha256(sum.SHA256).
+			SetMd5(sum.MD5).
+			SetBlobID(blobID).
+			SetManageToken(u.ManageToken).
+			SetNillablePassword(u.Password).
+			SetNillableMaxDownloads(u.MaxDownloads).
+			SetNillableExpiresAt(u.FileExpiresAt).
+			SetNillableOwnerID(u.OwnerID)
+		if u.Visibility != nil {
+			q.SetVisibility(file.Visibility(*u.Visibility))
+		}
+		if u.Mime != "unknown" {
+			q.SetDeclaredMime(u.Mime)
+		}
+		if err := tx.Upload.DeleteOne(u).Exec(s.ctx); err != nil {
+			return nil, err
+		}
+		return q.Save(s.ctx)
+	})
+	if err != nil {
+		return fail("Error while saving file, please send an empty chunk at the final offset to retry", err)
+	}
+
+	return file, nil
+}
+
+func (s *AttachedGinUpload) DeleteOne(u *ent.Upload, allowReply bool) error {
+	unlock := s.s.lock(u.ID)
+	defer unlock()
+
+	if err := os.Remove(filelib.GetPartialPathname(s.s.cfg, u)); err != nil && !os.IsNotExist(err) {
+		if allowReply {
+			reply.New(s.c).Error(reply.CodeServerError, "Error cannot remove upload file").Fail()
+		}
+		return err
+	}
+
+	err := s.dc.Upload.DeleteOne(u).Exec(s.ctx)
+	if allowReply && err != nil {
+		s.replyDbError(err)
+	}
+	return err
+}
@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"file-sharing/config"
+	"file-sharing/ent"
+	"file-sharing/ent/apikey"
+	"file-sharing/ent/user"
+	"file-sharing/internal/lib/authlib"
+	"file-sharing/internal/lib/crypto"
+	"file-sharing/internal/lib/reply"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+var ErrInvalidKey = errors.New("invalid api key")
+
+const (
+	keyPrefix       = "fs_" // Prefix of API keys, making leaked keys easy to search for
+	keyPrefixLength = 8     // Length of key prefix stored to tell keys apart
+)
+
+type User struct {
+	dc  *ent.Client
+	qs  *Quota
+	cfg *config.Config
+}
+
+// Credentials holds username and password of register and login requests.
+type Credentials struct {
+	Username string `form:"username" json:"username"`
+	Password string `form:"password" json:"password"`
+}
+
+// CreatedKey is a freshly created API key along with its plaintext key, which is only shown once.
+type CreatedKey struct {
+	*ent.ApiKey
+	Key string `json:"key"`
+}
+
+type AttachedGinUser struct {
+	dc  *ent.Client
+	qs  *Quota
+	cfg *config.Config
+	c   *gin.Context
+	ctx context.Context
+}
+
+// INIT
+
+func NewUser(client *ent.Client, cfg *config.Config) *User {
+	return &User{dc: client, qs: NewQuota(client, cfg), cfg: cfg}
+}
+
+func (s *User) AttachGin(c *gin.Context) *AttachedGinUser {
+	return &AttachedGinUser{s.dc, s.qs, s.cfg, c, c.Request.Context()}
+}
+
+// SERVICES
+
+// Authenticate returns owner of API key.
+func (s *User) Authenticate(ctx context.Context, key string) (*ent.User, error) {
+	k, err := s.dc.ApiKey.Query().Where(apikey.KeyHash(crypto.HashToken(key))).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Record usage at most once a minute, so requests don't always write
+	if k.LastUsedAt == nil || time.Since(*k.LastUsedAt) > time.Minute {
+		s.dc.ApiKey.UpdateOne(k).SetLastUsedAt(time.Now()).Exec(ctx)
+	}
+
+	u, err := s.dc.User.Get(ctx, k.UserID)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidKey
+	}
+	return u, err
+}
+
+func (s *AttachedGinUser) Register(cred *Credentials, allowReply bool) (*ent.User, error) {
+	rp := reply.New(s.c)
+	fail := func(message string) (*ent.User, error) {
+		if allowReply {
+			rp.Error(reply.CodeBadRequest, message).Fail()
+		}
+		return nil, errors.New(message)
+	}
+
+	username := strings.TrimSpace(cred.Username)
+	if !authlib.IsValidUsername(username) {
+		return fail("Username must be 3 to 32 letters, numbers, '_', '.' or '-'")
+	}
+	if !authlib.IsValidPassword(cred.Password) {
+		return fail("Password must be 8 to 72 characters")
+	}
+
+	u, err := s.dc.User.Create().
+		SetUsername(username).
+		SetPassword(crypto.HashPassword(cred.Password)).
+		Save(s.ctx)
+	if ent.IsConstraintError(err) {
+		if allowReply {
+			rp.Error(reply.CodeConflict, "Username is already taken").Fail()
+		}
+		return nil, err
+	}
+	if err != nil {
+		if allowReply {
+			rp.Error(reply.CodeBadGateWay, err.Error()).Fail()
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// Login exchanges username and password for a new API key.
+func (s *AttachedGinUser) Login(cred *Credentials, allowReply bool) (*CreatedKey, error) {
+	u, err := s.dc.User.Query().Where(user.Username(strings.TrimSpace(cred.Username))).Only(s.ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		if allowReply {
+			reply.New(s.c).Error(reply.CodeBadGateWay, err.Error()).Fail()
+		}
+		return nil, err
+	}
+
+	if u == nil || !crypto.ComparePassword(u.Password, cred.Password) {
+		if allowReply {
+			reply.New(s.c).Error(reply.CodeUnauthorized, "Wrong username or password").Fail()
+		}
+		return nil, errors.New("wrong username or password")
+	}
+
+	return s.CreateKey(u, "login", allowReply)
+}
+
+func (s *AttachedGinUser) CreateKey(u *ent.User, name string, allowReply bool) (*CreatedKey, error) {
+	key := keyPrefix + crypto.CreateSecret(s.cfg.SecretLength)
+
+	k, err := s.dc.ApiKey.Create().
+		SetUserID(u.ID).
+		SetName(strings.TrimSpace(name)).
+		SetKeyHash(crypto.HashToken(key)).
+		SetPrefix(key[:keyPrefixLength]).
+		Save(s.ctx)
+	if err != nil {
+		if allowReply {
+			reply.New(s.c).Error(reply.CodeBadGateWay, err.Error()).Fail()
+		}
+		return nil, err
+	}
+
+	return &CreatedKey{k, key}, nil
+}
+
+func (s *AttachedGinUser) GetKeys(u *ent.User) ([]*ent.ApiKey, error) {
+	return s.dc.ApiKey.Query().Where(apikey.UserID(u.ID)).Order(ent.Asc(apikey.FieldCreatedAt)).All(s.ctx)
+}
+
+// DeleteKey revokes API key id of u.
+func (s *AttachedGinUser) DeleteKey(u *ent.User, id string, allowReply bool) error {
+	n, err := s.dc.ApiKey.Delete().Where(apikey.ID(id), apikey.UserID(u.ID)).Exec(s.ctx)
+	if err == nil && n == 0 {
+		if allowReply {
+			reply.New(s.c).Error(reply.CodeNotFound, "API key not found").Fail()
+		}
+		return errors.New("api key not found")
+	}
+	if err != nil && allowReply {
+		reply.New(s.c).Error(reply.CodeBadGateWay, err.Error()).Fail()
+	}
+	return err
+}
+
+func (s *AttachedGinUser) GetUsage(u *ent.User, allowReply bool) (*Usage, error) {
+	usage, err := s.qs.GetUsage(s.ctx, u)
+	if err != nil && allowReply {
+		reply.New(s.c).Error(reply.CodeBadGateWay, err.Error()).Fail()
+	}
+	return usage, err
+}
+
+// SetPlan assigns quota plan to user of username, empty plan moves the user back to the default quota.
+func (s *AttachedGinUser) SetPlan(username, plan string, allowReply bool) (*ent.User, error) {
+	rp := reply.New(s.c)
+
+	q := s.dc.User.Update().Where(user.Username(username))
+	if plan == "" {
+		q.ClearPlan()
+	} else if _, ok := s.cfg.Plans[plan]; ok {
+		q.SetPlan(plan)
+	} else {
+		message := fmt.Sprintf("Unknown plan, please use one of %v", slices.Sorted(maps.Keys(s.cfg.Plans)))
+		if allowReply {
+			rp.Error(reply.CodeBadRequest, message).Fail()
+		}
+		return nil, errors.New(message)
+	}
+
+	n, err := q.Save(s.ctx)
+	if err == nil && n == 0 {
+		if allowReply {
+			rp.Error(reply.CodeNotFound, "User not found").Fail()
+		}
+		return nil, errors.New("user not found")
+	}
+	if err == nil {
+		var u *ent.User
+		if u, err = s.dc.User.Query().Where(user.Username(username)).Only(s.ctx); err == nil {
+			return u, nil
+		}
+	}
+
+	if allowReply {
+		rp.Error(reply.CodeBadGateWay, err.Error()).Fail()
+	}
+	return nil, err
+}
@@ -0,0 +1,270 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testBucket    = "bucket"
+	testAccessKey = "access"
+	testSecretKey = "secret"
+	testRegion    = "eu-test-1"
+)
+
+// fakeS3 is a MinIO-like stand-in serving a path-style bucket in memory, rejecting requests with a wrong SigV4 signature.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	modtime time.Time
+	page    int // Keys per listing page
+}
+
+func newFakeS3(t *testing.T) (*S3, *fakeS3) {
+	t.Helper()
+	f := &fakeS3{objects: map[string][]byte{}, modtime: time.Now().UTC().Truncate(time.Second), page: 2}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	s, err := NewS3(S3Options{
+		Endpoint:  srv.URL,
+		Region:    testRegion,
+		Bucket:    testBucket,
+		AccessKey: testAccessKey,
+		SecretKey: testSecretKey,
+		PathStyle: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, f
+}
+
+// uriEncode encodes s as AWS does, independently of the client's implementation.
+func uriEncode(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(url.QueryEscape(s), "+", "%20"), "%7E", "~")
+}
+
+// verify recomputes signature of r as the server sees it, canonical URI is the path exactly as sent.
+func (f *fakeS3) verify(r *http.Request) error {
+	auth := r.Header.Get("Authorization")
+	var cred, signed, sig string
+	if _, err := fmt.Sscanf(strings.ReplaceAll(auth, ",", ""), "AWS4-HMAC-SHA256 Credential=%s SignedHeaders=%s Signature=%s", &cred, &signed, &sig); err != nil {
+		return fmt.Errorf("malformed authorization %q", auth)
+	}
+	parts := strings.SplitN(cred, "/", 2)
+	if parts[0] != testAccessKey {
+		return errors.New("unknown access key")
+	}
+	scope := parts[1]
+	amzDate := r.Header.Get("X-Amz-Date")
+	date := amzDate[:8]
+	if scope != date+"/"+testRegion+"/s3/aws4_request" {
+		return fmt.Errorf("wrong scope %q", scope)
+	}
+
+	query, _ := url.ParseQuery(r.URL.RawQuery)
+	pairs := []string{}
+	for k, vs := range query {
+		for _, v := range vs {
+			pairs = append(pairs, uriEncode(k)+"="+uriEncode(v))
+		}
+	}
+	sort.Strings(pairs)
+
+	headers := ""
+	for _, h := range strings.Split(signed, ";") {
+		v := r.Header.Get(h)
+		if h == "host" {
+			v = r.Host
+		}
+		headers += h + ":" + strings.TrimSpace(v) + "\n"
+	}
+
+	rawPath, _, _ := strings.Cut(r.RequestURI, "?")
+	canonical := strings.Join([]string{r.Method, rawPath, strings.Join(pairs, "&"), headers, signed, r.Header.Get("X-Amz-Content-Sha256")}, "\n")
+	crh := sha256.Sum256([]byte(canonical))
+	toSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(crh[:])
+
+	key := []byte("AWS4" + testSecretKey)
+	for _, p := range []string{date, testRegion, "s3", "aws4_request"} {
+		key = hmacSHA256(key, p)
+	}
+	if want := hex.EncodeToString(hmacSHA256(key, toSign)); !hmac.Equal([]byte(sig), []byte(want)) {
+		return fmt.Errorf("signature does not match, canonical request:\n%v", canonical)
+	}
+	return nil
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := f.verify(r); err != nil {
+		http.Error(w, "SignatureDoesNotMatch: "+err.Error(), http.StatusForbidden)
+		return
+	}
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+testBucket+"/")
+	if !ok {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if key == "" && r.Method == http.MethodGet {
+		f.list(w, r)
+		return
+	}
+
+	data, exists := f.objects[key]
+	switch r.Method {
+	case http.MethodPut:
+		b, _ := io.ReadAll(r.Body)
+		if int64(len(b)) != r.ContentLength {
+			http.Error(w, "IncompleteBody", http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = b
+	case http.MethodGet, http.MethodHead:
+		if !exists {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Last-Modified", f.modtime.Format(http.TimeFormat))
+		http.ServeContent(w, r, key, f.modtime, bytes.NewReader(data))
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "MethodNotAllowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	keys := []string{}
+	for k := range f.objects {
+		if strings.HasPrefix(k, q.Get("prefix")) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	start, _ := strconv.Atoi(q.Get("continuation-token"))
+	end := min(start+f.page, len(keys))
+
+	var res listBucketResult
+	for _, k := range keys[start:end] {
+		res.Contents = append(res.Contents, struct {
+			Key          string    `xml:"Key"`
+			Size         int64     `xml:"Size"`
+			LastModified time.Time `xml:"LastModified"`
+		}{k, int64(len(f.objects[k])), f.modtime})
+	}
+	if end < len(keys) {
+		res.IsTruncated = true
+		res.NextContinuationToken = strconv.Itoa(end)
+	}
+	xml.NewEncoder(w).Encode(res)
+}
+
+func TestS3(t *testing.T) {
+	s, fake := newFakeS3(t)
+	ctx := context.Background()
+	content := []byte("0123456789abcdef")
+
+	// Keys of files stored before content-addressed storage carry share token and file name
+	keys := []string{
+		"small/7a485c6168c4a2421aba8cf0c1e6397be45c7c82c18585146672919d3c1c0111",
+		"small/tok##report (final) 100%+ü!.txt",
+		"large/tok##a b~c.bin",
+	}
+	for _, k := range keys {
+		if err := s.Put(ctx, k, bytes.NewReader(content), int64(len(content))); err != nil {
+			t.Fatalf("put %q: %v", k, err)
+		}
+		if !bytes.Equal(fake.objects[k], content) {
+			t.Fatalf("put %q: stored %q", k, fake.objects[k])
+		}
+	}
+
+	r, err := s.Open(ctx, keys[1], 4, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := io.ReadAll(r)
+	r.Close()
+	if string(got) != "456789" {
+		t.Fatalf("open range: got %q", got)
+	}
+
+	r, err = s.Open(ctx, keys[1], 10, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ = io.ReadAll(r)
+	r.Close()
+	if string(got) != "abcdef" {
+		t.Fatalf("open from offset: got %q", got)
+	}
+
+	info, err := s.Stat(ctx, keys[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != int64(len(content)) || !info.ModTime.Equal(fake.modtime) {
+		t.Fatalf("stat: got %+v", info)
+	}
+	if _, err := s.Stat(ctx, "small/missing"); !errors.Is(err, ErrNotExist) {
+		t.Fatalf("stat missing: got %v, want ErrNotExist", err)
+	}
+
+	// Listing spans pages of two keys
+	list, err := s.List(ctx, "small/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0].Key != keys[0] || list[1].Key != keys[1] {
+		t.Fatalf("list: got %v entries", len(list))
+	}
+	s.Put(ctx, "small/x", bytes.NewReader(content), int64(len(content)))
+	if list, err = s.List(ctx, "small/"); err != nil || len(list) != 3 {
+		t.Fatalf("list across pages: got %v entries, %v", len(list), err)
+	}
+
+	if err := s.Delete(ctx, keys[1]); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fake.objects[keys[1]]; ok {
+		t.Fatal("delete: object still stored")
+	}
+	if err := s.Delete(ctx, keys[1]); err != nil {
+		t.Fatalf("delete missing: %v", err)
+	}
+}
+
+func TestS3WrongSecret(t *testing.T) {
+	s, _ := newFakeS3(t)
+	s.opt.SecretKey = "wrong"
+
+	err := s.Put(context.Background(), "small/a", strings.NewReader("a"), 1)
+	if err == nil || !strings.Contains(err.Error(), "SignatureDoesNotMatch") {
+		t.Fatalf("put with wrong secret: got %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ReadSeeker reads an object of known size lazily, opening it again from the new offset after seeking.
+// It lets http.ServeContent serve ranges of any storage.
+type ReadSeeker struct {
+	ctx    context.Context
+	s      Storage
+	key    string
+	size   int64
+	offset int64
+	r      io.ReadCloser
+}
+
+func NewReadSeeker(ctx context.Context, s Storage, key string, size int64) *ReadSeeker {
+	return &ReadSeeker{ctx: ctx, s: s, key: key, size: size}
+}
+
+func (rs *ReadSeeker) Read(p []byte) (int, error) {
+	if rs.offset >= rs.size {
+		return 0, io.EOF
+	}
+	if rs.r == nil {
+		r, err := rs.s.Open(rs.ctx, rs.key, rs.offset, -1)
+		if err != nil {
+			return 0, err
+		}
+		rs.r = r
+	}
+
+	n, err := rs.r.Read(p)
+	rs.offset += int64(n)
+	return n, err
+}
+
+func (rs *ReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += rs.offset
+	case io.SeekEnd:
+		offset += rs.size
+	}
+	if offset < 0 {
+		return 0, errors.New("storage: negative seek position")
+	}
+
+	if offset != rs.offset {
+		rs.Close()
+		rs.offset = offset
+	}
+	return offset, nil
+}
+
+func (rs *ReadSeeker) Close() error {
+	if rs.r == nil {
+		return nil
+	}
+	err := rs.r.Close()
+	rs.r = nil
+	return err
+}
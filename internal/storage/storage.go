@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"file-sharing/config"
+	"fmt"
+	"io"
+	"time"
+)
+
+var ErrNotExist = errors.New("storage: object does not exist")
+
+// Info describes a stored object.
+type Info struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+// Storage keeps uploaded content by slash separated key, e.g. "large/<sha256>".
+type Storage interface {
+	// Put stores size bytes read from r as key, replacing existing object.
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	// Open reads object from offset, length -1 reads until the end.
+	Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+	// Stat returns ErrNotExist if key is not stored.
+	Stat(ctx context.Context, key string) (*Info, error)
+	// Delete removes key, deleting missing key is not an error.
+	Delete(ctx context.Context, key string) error
+	// List returns every object which key starts with prefix.
+	List(ctx context.Context, prefix string) ([]*Info, error)
+}
+
+// New creates storage selected by cfg.StorageDriver.
+func New(cfg *config.Config) (Storage, error) {
+	switch cfg.StorageDriver {
+	case "local":
+		return NewLocal(cfg.UploadPath), nil
+	case "s3":
+		return NewS3(S3Options{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			PathStyle: cfg.S3PathStyle,
+		})
+	}
+	return nil, fmt.Errorf("storage: unknown driver %q", cfg.StorageDriver)
+}
+
+// Move renames an object, using native rename if storage supports it, otherwise copying then deleting.
+func Move(ctx context.Context, s Storage, from, to string) error {
+	if r, ok := s.(interface {
+		Rename(ctx context.Context, from, to string) error
+	}); ok {
+		return r.Rename(ctx, from, to)
+	}
+
+	info, err := s.Stat(ctx, from)
+	if err != nil {
+		return err
+	}
+	r, err := s.Open(ctx, from, 0, -1)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if err := s.Put(ctx, to, r, info.Size); err != nil {
+		return err
+	}
+	return s.Delete(ctx, from)
+}
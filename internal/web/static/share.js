@@ -0,0 +1,137 @@
+"use strict";
+
+// Decrypts a client-encrypted file in the browser, its key is in the URL fragment which browsers never send to the server.
+//
+// Format of files uploaded with 'encryption=client':
+// - Secret is 32 random bytes, put in the link fragment as unpadded base64url: /web/share/<token>#<secret>
+// - Content and metadata keys are AES-256-GCM keys derived from the secret by HKDF-SHA256 with empty salt
+//   and info "file-sharing content" or "file-sharing metadata".
+// - Content is sealed in segments of 64 KiB plaintext, each followed by its 16 bytes tag. Nonce of a segment is
+//   its index as 11 bytes big endian followed by 1 for the last segment, 0 otherwise. Empty content is one empty segment.
+// - Metadata is JSON {"name": "...", "type": "..."} sealed with a random 12 bytes nonce put before it,
+//   sent as unpadded base64url in 'metadata' form field.
+
+const SEGMENT = 64 * 1024;
+const TAG = 16;
+
+const $ = (id) => document.getElementById(id);
+
+function fromBase64url(s) {
+  const bin = atob(s.replace(/-/g, "+").replace(/_/g, "/"));
+  return Uint8Array.from(bin, (c) => c.charCodeAt(0));
+}
+
+function formatSize(bytes) {
+  const units = ["B", "KB", "MB", "GB"];
+  let i = 0;
+  while (bytes >= 1024 && i < units.length - 1) {
+    bytes /= 1024;
+    i++;
+  }
+  return `${bytes.toFixed(i ? 1 : 0)} ${units[i]}`;
+}
+
+async function deriveKey(secret, info) {
+  const base = await crypto.subtle.importKey("raw", secret, "HKDF", false, ["deriveKey"]);
+  return crypto.subtle.deriveKey(
+    { name: "HKDF", hash: "SHA-256", salt: new Uint8Array(), info: new TextEncoder().encode(info) },
+    base,
+    { name: "AES-GCM", length: 256 },
+    false,
+    ["decrypt"],
+  );
+}
+
+function segmentNonce(i, last) {
+  const nonce = new Uint8Array(12);
+  new DataView(nonce.buffer).setBigUint64(3, BigInt(i));
+  nonce[11] = last ? 1 : 0;
+  return nonce;
+}
+
+async function decryptMetadata(key, encoded) {
+  const sealed = fromBase64url(encoded);
+  const plain = await crypto.subtle.decrypt({ name: "AES-GCM", iv: sealed.subarray(0, 12) }, key, sealed.subarray(12));
+  return JSON.parse(new TextDecoder().decode(plain));
+}
+
+async function decryptContent(key, sealed) {
+  const count = Math.max(1, Math.ceil(sealed.byteLength / (SEGMENT + TAG)));
+  const parts = [];
+  for (let i = 0; i < count; i++) {
+    const segment = sealed.subarray(i * (SEGMENT + TAG), (i + 1) * (SEGMENT + TAG));
+    parts.push(await crypto.subtle.decrypt({ name: "AES-GCM", iv: segmentNonce(i, i === count - 1) }, key, segment));
+  }
+  return parts;
+}
+
+// api fetches path, throwing message of error reply
+async function api(path, options) {
+  const res = await fetch(path, options);
+  if (!res.ok) {
+    const body = await res.json().catch(() => null);
+    throw new Error(body?.data?.message ?? res.statusText);
+  }
+  return res;
+}
+
+function setStatus(message, error) {
+  $("status").textContent = message;
+  $("status").className = error ? "error" : "";
+}
+
+async function download(token, secret, meta) {
+  const password = $("password").value;
+  const headers = password ? { "X-Password": password } : {};
+
+  setStatus("Downloading...");
+  const res = await api(`/files/${encodeURIComponent(token)}/download`, { headers });
+  const sealed = new Uint8Array(await res.arrayBuffer());
+
+  setStatus("Decrypting...");
+  let parts;
+  try {
+    parts = await decryptContent(await deriveKey(secret, "file-sharing content"), sealed);
+  } catch {
+    throw new Error("File can not be decrypted, its content is corrupted");
+  }
+
+  const url = URL.createObjectURL(new Blob(parts, { type: meta.type || "application/octet-stream" }));
+  const a = document.createElement("a");
+  a.href = url;
+  a.download = meta.name || "download";
+  a.click();
+  setTimeout(() => URL.revokeObjectURL(url), 60 * 1000);
+  setStatus("Done");
+}
+
+async function main() {
+  const token = decodeURIComponent(location.pathname.split("/").pop());
+  const secret = fromBase64url(location.hash.slice(1));
+  if (secret.length !== 32) {
+    throw new Error("Link is missing its key, please use the full link");
+  }
+
+  const file = (await (await api(`/files/${encodeURIComponent(token)}`)).json()).data;
+  if (file.encryption !== "client") {
+    throw new Error("File was not encrypted by its uploader");
+  }
+
+  let meta;
+  try {
+    meta = await decryptMetadata(await deriveKey(secret, "file-sharing metadata"), file.metadata);
+  } catch {
+    throw new Error("Key in this link does not match the file");
+  }
+
+  document.title = meta.name;
+  $("name").textContent = meta.name;
+  $("size").textContent = formatSize(Math.max(0, file.file_size - Math.max(1, Math.ceil(file.file_size / (SEGMENT + TAG))) * TAG));
+  $("form").hidden = false;
+  $("form").addEventListener("submit", (e) => {
+    e.preventDefault();
+    download(token, secret, meta).catch((err) => setStatus(err.message, true));
+  });
+}
+
+main().catch((err) => setStatus(err.message, true));
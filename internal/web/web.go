@@ -0,0 +1,16 @@
+package web
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+//go:embed static
+var static embed.FS
+
+// FS returns static pages and their assets, they only talk to the API from the browser.
+func FS() http.FileSystem {
+	sub, _ := fs.Sub(static, "static")
+	return http.FS(sub)
+}